@@ -83,6 +83,12 @@ const RFC3339RegexPattern = `^[0-9]{4}-(0[1-9]|1[012])-(0[1-9]|[12][0-9]|3[01])[
 const regionRegexp = `[a-z]{2}(-[a-z]+)+-\d`
 const accountIDRegexp = `(aws|aws-managed|\d{12})`
 
+// Static credentials used with local AWS endpoint emulators.
+const (
+	localEndpointAccessKeyID     = "test"
+	localEndpointSecretAccessKey = "test"
+)
+
 // Skip implements a wrapper for (*testing.T).Skip() to prevent unused linting reports
 //
 // Reference: https://github.com/dominikh/go-tools/issues/633#issuecomment-606560616
@@ -121,6 +127,15 @@ func init() {
 	if err != nil {
 		panic(err)
 	}
+
+	configureLocalEndpoint(Provider)
+}
+
+// configureLocalEndpoint targets the specified provider at any local AWS endpoint emulator.
+func configureLocalEndpoint(p *schema.Provider) {
+	if IsLocalEndpoint() {
+		provider.SetLocalEndpoint(p, os.Getenv(envvar.LocalEndpoint))
+	}
 }
 
 func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string) map[string]func() (tfprotov5.ProviderServer, error) {
@@ -128,12 +143,14 @@ func protoV5ProviderFactoriesInit(ctx context.Context, providerNames ...string)
 
 	for _, name := range providerNames {
 		factories[name] = func() (tfprotov5.ProviderServer, error) {
-			providerServerFactory, _, err := provider.ProtoV5ProviderServerFactory(ctx)
+			providerServerFactory, p, err := provider.ProtoV5ProviderServerFactory(ctx)
 
 			if err != nil {
 				return nil, err
 			}
 
+			configureLocalEndpoint(p)
+
 			return providerServerFactory(), nil
 		}
 	}
@@ -151,6 +168,8 @@ func protoV5ProviderFactoriesNamedInit(ctx context.Context, t *testing.T, provid
 			t.Fatal(err)
 		}
 
+		configureLocalEndpoint(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -171,6 +190,8 @@ func protoV5ProviderFactoriesPlusProvidersInit(ctx context.Context, t *testing.T
 			t.Fatal(err)
 		}
 
+		configureLocalEndpoint(p)
+
 		factories[name] = func() (tfprotov5.ProviderServer, error) { //nolint:unparam
 			return providerServerFactory(), nil
 		}
@@ -247,7 +268,15 @@ func PreCheck(ctx context.Context, t *testing.T) {
 	// Since we are outside the scope of the Terraform configuration we must
 	// call Configure() to properly initialize the provider configuration.
 	testAccProviderConfigure.Do(func() {
-		envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		if IsLocalEndpoint() {
+			// Local AWS endpoint emulators accept any static credentials.
+			if os.Getenv(envvar.Profile) == "" && os.Getenv(envvar.AccessKeyId) == "" {
+				os.Setenv(envvar.AccessKeyId, localEndpointAccessKeyID)
+				os.Setenv(envvar.SecretAccessKey, localEndpointSecretAccessKey)
+			}
+		} else {
+			envvar.FailIfAllEmpty(t, []string{envvar.Profile, envvar.AccessKeyId, envvar.ContainerCredentialsFullURI}, "credentials for running acceptance testing")
+		}
 
 		if os.Getenv(envvar.AccessKeyId) != "" {
 			envvar.FailIfEmpty(t, envvar.SecretAccessKey, "static credentials value when using "+envvar.AccessKeyId)
@@ -844,6 +873,20 @@ func thirdRegionPartition() string {
 	return endpoints.AwsPartitionID
 }
 
+// IsLocalEndpoint returns whether acceptance tests target a local AWS endpoint emulator.
+func IsLocalEndpoint() bool {
+	return os.Getenv(envvar.LocalEndpoint) != ""
+}
+
+// PreCheckNotLocalEndpoint skips a test that is known to fail against a local AWS endpoint emulator.
+func PreCheckNotLocalEndpoint(t *testing.T) {
+	t.Helper()
+
+	if IsLocalEndpoint() {
+		t.Skipf("skipping tests; not supported by local AWS endpoint emulator (%s)", os.Getenv(envvar.LocalEndpoint))
+	}
+}
+
 func PreCheckAlternateAccount(t *testing.T) {
 	envvar.SkipIfAllEmpty(t, []string{envvar.AlternateProfile, envvar.AlternateAccessKeyId}, "credentials for running acceptance testing in alternate AWS account")
 
@@ -1368,7 +1411,7 @@ func RegionProviderFunc(region string, providers *[]*schema.Provider) func() *sc
 				continue
 			}
 
-			clientRegion := client.Region
+			clientRegion := client.Region(context.Background()) // The provider's configured Region.
 			log.Printf("[DEBUG] Checking AWS provider region %q against %q", clientRegion, region)
 			if clientRegion == region {
 				log.Printf("[DEBUG] Found AWS provider with region: %s", region)
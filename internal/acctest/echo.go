@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+	providerschema "github.com/hashicorp/terraform-plugin-framework/provider/schema"
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// ProtoV6EchoProviderFactories is a static map containing only the "echo" provider,
+// which is used to inspect the values of ephemeral resources in acceptance tests.
+// The provider's `data` argument, which can reference ephemeral values, is copied to the `data`
+// attribute of any `echo` resource:
+//
+//	provider "echo" {
+//	  data = ephemeral.aws_ssm_parameter.test
+//	}
+//
+//	resource "echo" "test" {}
+var ProtoV6EchoProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+	"echo": providerserver.NewProtocol6WithError(&echoProvider{}),
+}
+
+// SkipBelowEphemeralResourceVersion skips tests on Terraform CLI versions that do not support ephemeral resources.
+var SkipBelowEphemeralResourceVersion = tfversion.SkipBelow(version.Must(version.NewVersion("1.10.0")))
+
+var echoDataPath = path.Root("data")
+
+type echoProvider struct{}
+
+func (p *echoProvider) Metadata(_ context.Context, request provider.MetadataRequest, response *provider.MetadataResponse) {
+	response.TypeName = "echo"
+}
+
+func (p *echoProvider) Schema(_ context.Context, request provider.SchemaRequest, response *provider.SchemaResponse) {
+	response.Schema = providerschema.Schema{
+		Attributes: map[string]providerschema.Attribute{
+			"data": providerschema.DynamicAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (p *echoProvider) Configure(ctx context.Context, request provider.ConfigureRequest, response *provider.ConfigureResponse) {
+	var data types.Dynamic
+
+	response.Diagnostics.Append(request.Config.GetAttribute(ctx, echoDataPath, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.ResourceData = data
+}
+
+func (p *echoProvider) DataSources(context.Context) []func() datasource.DataSource {
+	return nil
+}
+
+func (p *echoProvider) Resources(context.Context) []func() resource.Resource {
+	return []func() resource.Resource{
+		func() resource.Resource {
+			return &echoResource{}
+		},
+	}
+}
+
+type echoResource struct {
+	data types.Dynamic
+}
+
+func (r *echoResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "echo"
+}
+
+func (r *echoResource) Schema(_ context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = resourceschema.Schema{
+		Attributes: map[string]resourceschema.Attribute{
+			"data": resourceschema.DynamicAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *echoResource) Configure(_ context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(types.Dynamic); ok {
+		r.data = v
+	}
+}
+
+func (r *echoResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, echoDataPath, r.data)...)
+}
+
+func (r *echoResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	// The echoed value is only ever set on Create and Update.
+}
+
+func (r *echoResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, echoDataPath, r.data)...)
+}
+
+func (r *echoResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
@@ -9,17 +9,26 @@ import (
 	"crypto/tls"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"math/rand"
+	"mime"
 	"net/http"
+	"net/url"
 	"os"
 	"path/filepath"
 	"reflect"
+	"slices"
 	"strconv"
 	"strings"
 	"testing"
 
+	"github.com/YakDriver/regexache"
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
 	cleanhttp "github.com/hashicorp/go-cleanhttp"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -28,6 +37,7 @@ import (
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
 	"gopkg.in/dnaeon/go-vcr.v3/cassette"
@@ -117,6 +127,7 @@ func vcrEnabledProtoV5ProviderFactories(t *testing.T, input map[string]func() (t
 				return nil, err
 			}
 
+			configureLocalEndpoint(primary)
 			primary.ConfigureContextFunc = vcrProviderConfigureContextFunc(primary, primary.ConfigureContextFunc, t.Name())
 
 			return providerServerFactory(), nil
@@ -162,94 +173,21 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 
 		// Create a VCR recorder around a default HTTP client.
 		r, err := recorder.NewWithOptions(&recorder.Options{
-			CassetteName:  path,
-			Mode:          vcrMode,
-			RealTransport: httpClient.Transport,
+			CassetteName:       path,
+			Mode:               vcrMode,
+			RealTransport:      httpClient.Transport,
+			SkipRequestLatency: vcrMode == recorder.ModeReplayOnly,
 		})
 
 		if err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
 
-		// Remove sensitive HTTP headers.
-		r.AddHook(func(i *cassette.Interaction) error {
-			delete(i.Request.Headers, "Authorization")
-			delete(i.Request.Headers, "X-Amz-Security-Token")
-
-			return nil
-		}, recorder.AfterCaptureHook)
+		// Remove sensitive data before the interaction is saved.
+		r.AddHook(vcrScrubInteraction, recorder.AfterCaptureHook)
 
 		// Defines how VCR will match requests to responses.
-		r.SetMatcher(func(r *http.Request, i cassette.Request) bool {
-			// Default matcher compares method and URL only.
-			if !cassette.DefaultMatcher(r, i) {
-				return false
-			}
-
-			if r.Body == nil {
-				return true
-			}
-
-			var b bytes.Buffer
-			if _, err := b.ReadFrom(r.Body); err != nil {
-				tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
-					"error": err,
-				})
-				return false
-			}
-
-			r.Body = io.NopCloser(&b)
-			body := b.String()
-			// If body matches identically, we are done.
-			if body == i.Body {
-				return true
-			}
-
-			// https://awslabs.github.io/smithy/1.0/spec/aws/index.html#aws-protocols.
-			switch contentType := r.Header.Get("Content-Type"); contentType {
-			case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
-				// JSON might be the same, but reordered. Try parsing and comparing.
-				var requestJson, cassetteJson interface{}
-
-				if err := json.Unmarshal([]byte(body), &requestJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := json.Unmarshal([]byte(i.Body), &cassetteJson); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette JSON", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestJson, cassetteJson)
-
-			case "application/xml":
-				// XML might be the same, but reordered. Try parsing and comparing.
-				var requestXml, cassetteXml interface{}
-
-				if err := xml.Unmarshal([]byte(body), &requestXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal request XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				if err := xml.Unmarshal([]byte(i.Body), &cassetteXml); err != nil {
-					tflog.Debug(ctx, "Failed to unmarshal cassette XML", map[string]interface{}{
-						"error": err,
-					})
-					return false
-				}
-
-				return reflect.DeepEqual(requestXml, cassetteXml)
-			}
-
-			return false
-		})
+		r.SetMatcher(vcrRequestMatcher(ctx))
 
 		// Use the wrapped HTTP Client for AWS APIs.
 		// As the HTTP client is used in the provider's ConfigureContextFunc
@@ -270,15 +208,18 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 		}
 
 		// Don't retry requests if a recorded interaction isn't found.
-		// TODO Need to loop through all API clients to do this.
-		// TODO Use []*client.Client?
-		// TODO AWS SDK for Go v2 API clients.
-		// meta.LogsConn(ctx).Handlers.AfterRetry.PushFront(func(r *request.Request) {
-		// 	// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
-		// 	if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
-		// 		r.Retryable = aws.Bool(false)
-		// 	}
-		// })
+		meta.Session.Handlers.AfterRetry.PushFront(func(r *request.Request) {
+			// We have to use 'Contains' rather than 'errors.Is' because 'awserr.Error' doesn't implement 'Unwrap'.
+			if errs.Contains(r.Error, cassette.ErrInteractionNotFound.Error()) {
+				r.Retryable = aws.Bool(false)
+			}
+		})
+		meta.AddIsErrorRetryables(retry.IsErrorRetryableFunc(func(err error) aws_sdkv2.Ternary {
+			if errors.Is(err, cassette.ErrInteractionNotFound) {
+				return aws_sdkv2.FalseTernary
+			}
+			return aws_sdkv2.UnknownTernary
+		}))
 
 		providerMetas[testName] = meta
 
@@ -286,6 +227,238 @@ func vcrProviderConfigureContextFunc(provider *schema.Provider, configureContext
 	}
 }
 
+// vcrIdempotencyTokenNames are the names of request members that the AWS SDKs populate with random idempotency tokens.
+var vcrIdempotencyTokenNames = []string{
+	"ClientRequestToken",
+	"ClientToken",
+	"IdempotencyToken",
+}
+
+// vcrIdempotencyTokenWildcard replaces idempotency token values so that recorded and actual requests compare equal.
+const vcrIdempotencyTokenWildcard = "*"
+
+// vcrPresignQueryParams are the SigV4 query string parameters added to presigned URLs.
+var vcrPresignQueryParams = []string{
+	"X-Amz-Algorithm",
+	"X-Amz-Credential",
+	"X-Amz-Date",
+	"X-Amz-Security-Token",
+	"X-Amz-Signature",
+	"X-Amz-SignedHeaders",
+}
+
+// vcrSensitiveHeaders are the HTTP request headers removed from recorded interactions.
+var vcrSensitiveHeaders = []string{
+	"Authorization",
+	"X-Amz-Security-Token",
+}
+
+var (
+	vcrSensitiveJSONMembersRegexp = regexache.MustCompile(`(?i)("(?:AccessKeyId|SecretAccessKey|SessionToken)"\s*:\s*)"[^"]*"`)
+	vcrSensitiveXMLElementsRegexp = regexache.MustCompile(`(<(?:AccessKeyId|SecretAccessKey|SessionToken)>)[^<]*(</)`)
+)
+
+const vcrRedacted = "REDACTED"
+
+// vcrScrubInteraction removes credentials and signatures from a recorded interaction.
+func vcrScrubInteraction(i *cassette.Interaction) error {
+	for _, v := range vcrSensitiveHeaders {
+		i.Request.Headers.Del(v)
+	}
+
+	if u, err := url.Parse(i.Request.URL); err == nil {
+		query, redacted := u.Query(), false
+		for _, v := range vcrPresignQueryParams {
+			if query.Has(v) {
+				query.Set(v, vcrRedacted)
+				redacted = true
+			}
+		}
+		if redacted {
+			u.RawQuery = query.Encode()
+			i.Request.URL = u.String()
+		}
+	}
+
+	body := vcrSensitiveJSONMembersRegexp.ReplaceAllString(i.Response.Body, `${1}"`+vcrRedacted+`"`)
+	body = vcrSensitiveXMLElementsRegexp.ReplaceAllString(body, `${1}`+vcrRedacted+`${2}`)
+	if body != i.Response.Body {
+		i.Response.Body = body
+		i.Response.ContentLength = int64(len(body))
+		if i.Response.Headers.Get("Content-Length") != "" {
+			i.Response.Headers.Set("Content-Length", strconv.Itoa(len(body)))
+		}
+	}
+
+	return nil
+}
+
+// vcrRequestMatcher returns a VCR matcher that compares requests using their AWS protocol semantics.
+// Query string parameters are compared independent of order, bodies are compared structurally and
+// idempotency tokens and SigV4 presigning parameters are ignored.
+func vcrRequestMatcher(ctx context.Context) cassette.MatcherFunc {
+	return func(r *http.Request, i cassette.Request) bool {
+		if r.Method != i.Method {
+			return false
+		}
+
+		u, err := url.Parse(i.URL)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to parse cassette URL", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		if !vcrURLEqual(r.URL, u) {
+			return false
+		}
+
+		if r.Body == nil {
+			return true
+		}
+
+		var b bytes.Buffer
+		if _, err := b.ReadFrom(r.Body); err != nil {
+			tflog.Debug(ctx, "Failed to read request body from cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		r.Body = io.NopCloser(&b)
+		body := b.String()
+		// If body matches identically, we are done.
+		if body == i.Body {
+			return true
+		}
+
+		contentType, _, err := mime.ParseMediaType(r.Header.Get("Content-Type"))
+		if err != nil {
+			return false
+		}
+
+		equal, err := vcrBodyEqual(contentType, body, i.Body)
+		if err != nil {
+			tflog.Debug(ctx, "Failed to compare request body with cassette", map[string]interface{}{
+				"error": err,
+			})
+			return false
+		}
+
+		return equal
+	}
+}
+
+// vcrURLEqual returns whether two request URLs are equal, ignoring query string parameter order and SigV4 presigning parameters.
+func vcrURLEqual(a, b *url.URL) bool {
+	if a.Scheme != b.Scheme || a.Host != b.Host || a.EscapedPath() != b.EscapedPath() {
+		return false
+	}
+
+	normalize := func(query url.Values) string {
+		for _, v := range vcrPresignQueryParams {
+			query.Del(v)
+		}
+		return query.Encode()
+	}
+
+	return normalize(a.Query()) == normalize(b.Query())
+}
+
+// vcrBodyEqual returns whether two request bodies are equal for the specified AWS protocol content type.
+// See https://smithy.io/2.0/aws/protocols/index.html.
+func vcrBodyEqual(contentType, a, b string) (bool, error) {
+	switch contentType {
+	case "application/json", "application/x-amz-json-1.0", "application/x-amz-json-1.1":
+		// JSON might be the same, but reordered. Try parsing and comparing.
+		var aJSON, bJSON interface{}
+
+		if err := json.Unmarshal([]byte(a), &aJSON); err != nil {
+			return false, fmt.Errorf("unmarshaling request JSON: %w", err)
+		}
+
+		if err := json.Unmarshal([]byte(b), &bJSON); err != nil {
+			return false, fmt.Errorf("unmarshaling cassette JSON: %w", err)
+		}
+
+		return reflect.DeepEqual(vcrWildcardJSONIdempotencyTokens(aJSON), vcrWildcardJSONIdempotencyTokens(bJSON)), nil
+
+	case "application/x-www-form-urlencoded":
+		// AWS Query and EC2 protocols. Parameters might be the same, but reordered.
+		aQuery, err := url.ParseQuery(a)
+		if err != nil {
+			return false, fmt.Errorf("parsing request query: %w", err)
+		}
+
+		bQuery, err := url.ParseQuery(b)
+		if err != nil {
+			return false, fmt.Errorf("parsing cassette query: %w", err)
+		}
+
+		return vcrWildcardQueryIdempotencyTokens(aQuery).Encode() == vcrWildcardQueryIdempotencyTokens(bQuery).Encode(), nil
+
+	case "application/xml", "text/xml":
+		// XML might be the same, but reordered. Try parsing and comparing.
+		var aXML, bXML interface{}
+
+		if err := xml.Unmarshal([]byte(a), &aXML); err != nil {
+			return false, fmt.Errorf("unmarshaling request XML: %w", err)
+		}
+
+		if err := xml.Unmarshal([]byte(b), &bXML); err != nil {
+			return false, fmt.Errorf("unmarshaling cassette XML: %w", err)
+		}
+
+		return reflect.DeepEqual(aXML, bXML), nil
+	}
+
+	return false, nil
+}
+
+func vcrIsIdempotencyTokenName(name string) bool {
+	return slices.ContainsFunc(vcrIdempotencyTokenNames, func(v string) bool {
+		return strings.EqualFold(v, name)
+	})
+}
+
+// vcrWildcardJSONIdempotencyTokens replaces the values of any idempotency token members in a decoded JSON value.
+func vcrWildcardJSONIdempotencyTokens(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			if _, ok := e.(string); ok && vcrIsIdempotencyTokenName(k) {
+				v[k] = vcrIdempotencyTokenWildcard
+			} else {
+				v[k] = vcrWildcardJSONIdempotencyTokens(e)
+			}
+		}
+	case []interface{}:
+		for k, e := range v {
+			v[k] = vcrWildcardJSONIdempotencyTokens(e)
+		}
+	}
+
+	return v
+}
+
+// vcrWildcardQueryIdempotencyTokens replaces the values of any idempotency token parameters in AWS Query protocol parameters.
+// Nested parameter names are dot-separated, e.g. "LaunchTemplateData.ClientToken".
+func vcrWildcardQueryIdempotencyTokens(query url.Values) url.Values {
+	for k := range query {
+		name := k
+		if i := strings.LastIndex(k, "."); i >= 0 {
+			name = k[i+1:]
+		}
+
+		if vcrIsIdempotencyTokenName(name) {
+			query.Set(k, vcrIdempotencyTokenWildcard)
+		}
+	}
+
+	return query
+}
+
 // vcrRandomnessSource returns a rand.Source for VCR testing.
 // In RECORDING mode, generates a new seed and saves it to a file, using the seed for the source.
 // In REPLAYING mode, reads a seed from a file and creates a source from it.
@@ -4,9 +4,13 @@
 package acctest_test
 
 import (
+	"context"
+	"net/http"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"gopkg.in/dnaeon/go-vcr.v3/cassette"
 )
 
 func TestRandInt(t *testing.T) { //nolint:paralleltest
@@ -48,3 +52,191 @@ func TestRandomWithPrefix(t *testing.T) { //nolint:paralleltest
 		t.Errorf("REPLAYING: %s, RECORDING: %s", rep2, rec2)
 	}
 }
+
+func TestVCRRequestMatcher(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		method      string
+		url         string
+		contentType string
+		body        string
+		cassette    cassette.Request
+		expected    bool
+	}{
+		{
+			name:   "method mismatch",
+			method: http.MethodGet,
+			url:    "https://example.com/",
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://example.com/",
+			},
+		},
+		{
+			name:   "query parameters reordered",
+			method: http.MethodGet,
+			url:    "https://s3.us-west-2.amazonaws.com/bucket?prefix=a&list-type=2",
+			cassette: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://s3.us-west-2.amazonaws.com/bucket?list-type=2&prefix=a",
+			},
+			expected: true,
+		},
+		{
+			name:   "query parameters differ",
+			method: http.MethodGet,
+			url:    "https://s3.us-west-2.amazonaws.com/bucket?prefix=a",
+			cassette: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://s3.us-west-2.amazonaws.com/bucket?prefix=b",
+			},
+		},
+		{
+			name:   "presigned URL",
+			method: http.MethodGet,
+			url:    "https://bucket.s3.amazonaws.com/key?X-Amz-Credential=AKIA&X-Amz-Date=20240101T000000Z&X-Amz-Signature=abc",
+			cassette: cassette.Request{
+				Method: http.MethodGet,
+				URL:    "https://bucket.s3.amazonaws.com/key?X-Amz-Credential=REDACTED&X-Amz-Date=REDACTED&X-Amz-Signature=REDACTED",
+			},
+			expected: true,
+		},
+		{
+			name:        "JSON reordered",
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupName":"test","tags":{"k":"v"}}`,
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"tags":{"k":"v"},"logGroupName":"test"}`,
+			},
+			expected: true,
+		},
+		{
+			name:        "JSON differs",
+			method:      http.MethodPost,
+			url:         "https://logs.us-west-2.amazonaws.com/",
+			contentType: "application/x-amz-json-1.1",
+			body:        `{"logGroupName":"test1"}`,
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://logs.us-west-2.amazonaws.com/",
+				Body:   `{"logGroupName":"test2"}`,
+			},
+		},
+		{
+			name:        "JSON idempotency token",
+			method:      http.MethodPost,
+			url:         "https://lambda.us-west-2.amazonaws.com/",
+			contentType: "application/json; charset=utf-8",
+			body:        `{"Name":"test","Config":{"clientToken":"11111111-1111-1111-1111-111111111111"}}`,
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://lambda.us-west-2.amazonaws.com/",
+				Body:   `{"Config":{"clientToken":"22222222-2222-2222-2222-222222222222"},"Name":"test"}`,
+			},
+			expected: true,
+		},
+		{
+			name:        "query protocol reordered",
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=RunInstances&ImageId=ami-123&Version=2016-11-15",
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Version=2016-11-15&Action=RunInstances&ImageId=ami-123",
+			},
+			expected: true,
+		},
+		{
+			name:        "query protocol idempotency token",
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=CreateLaunchTemplate&ClientToken=aaa&Version=2016-11-15",
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Action=CreateLaunchTemplate&ClientToken=bbb&Version=2016-11-15",
+			},
+			expected: true,
+		},
+		{
+			name:        "query protocol differs",
+			method:      http.MethodPost,
+			url:         "https://ec2.us-west-2.amazonaws.com/",
+			contentType: "application/x-www-form-urlencoded",
+			body:        "Action=RunInstances&ImageId=ami-123&Version=2016-11-15",
+			cassette: cassette.Request{
+				Method: http.MethodPost,
+				URL:    "https://ec2.us-west-2.amazonaws.com/",
+				Body:   "Action=RunInstances&ImageId=ami-456&Version=2016-11-15",
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			r, err := http.NewRequest(testCase.method, testCase.url, strings.NewReader(testCase.body))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if testCase.contentType != "" {
+				r.Header.Set("Content-Type", testCase.contentType)
+			}
+
+			if got, want := acctest.VCRRequestMatcher(context.Background())(r, testCase.cassette), testCase.expected; got != want {
+				t.Errorf("got %t, want %t", got, want)
+			}
+		})
+	}
+}
+
+func TestVCRScrubInteraction(t *testing.T) {
+	t.Parallel()
+
+	i := &cassette.Interaction{
+		Request: cassette.Request{
+			Headers: http.Header{
+				"Authorization":        []string{"AWS4-HMAC-SHA256 Credential=AKIA/20240101/us-west-2/sts/aws4_request"},
+				"X-Amz-Security-Token": []string{"token"},
+				"Content-Type":         []string{"application/x-www-form-urlencoded"},
+			},
+			URL: "https://bucket.s3.amazonaws.com/key?X-Amz-Credential=AKIA&X-Amz-Signature=abc",
+		},
+		Response: cassette.Response{
+			Body:    `<AssumeRoleResult><Credentials><AccessKeyId>ASIA</AccessKeyId><SecretAccessKey>secret</SecretAccessKey><SessionToken>token</SessionToken></Credentials></AssumeRoleResult>`,
+			Headers: http.Header{"Content-Length": []string{"1"}},
+		},
+	}
+
+	if err := acctest.VCRScrubInteraction(i); err != nil {
+		t.Fatal(err)
+	}
+
+	if v := i.Request.Headers.Get("Authorization"); v != "" {
+		t.Errorf("Authorization header not removed: %s", v)
+	}
+	if v := i.Request.Headers.Get("X-Amz-Security-Token"); v != "" {
+		t.Errorf("X-Amz-Security-Token header not removed: %s", v)
+	}
+	if got, want := i.Request.URL, "https://bucket.s3.amazonaws.com/key?X-Amz-Credential=REDACTED&X-Amz-Signature=REDACTED"; got != want {
+		t.Errorf("URL: got %s, want %s", got, want)
+	}
+	if got, want := i.Response.Body, `<AssumeRoleResult><Credentials><AccessKeyId>REDACTED</AccessKeyId><SecretAccessKey>REDACTED</SecretAccessKey><SessionToken>REDACTED</SessionToken></Credentials></AssumeRoleResult>`; got != want {
+		t.Errorf("body: got %s, want %s", got, want)
+	}
+	if got, want := i.Response.ContentLength, int64(len(i.Response.Body)); got != want {
+		t.Errorf("ContentLength: got %d, want %d", got, want)
+	}
+}
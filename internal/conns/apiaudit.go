@@ -0,0 +1,279 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sync"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	awshttp_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/transport/http"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+const (
+	APIAuditLogFormatJSONL = "jsonl"
+)
+
+func APIAuditLogFormat_Values() []string {
+	return []string{
+		APIAuditLogFormatJSONL,
+	}
+}
+
+const (
+	redacted = "[REDACTED]"
+)
+
+var (
+	// sensitiveParameterRegexp matches the names of API parameters whose values are never written to the audit log.
+	sensitiveParameterRegexp = regexache.MustCompile(`(?i)(password|secret|token|credential|key|policy|document|certificate|private|userdata|body|content|data)`)
+	// identifierParameterRegexp matches the names of API parameters that are known to hold identifiers.
+	// AWS SDK for Go v2 input structs carry no sensitivity information, so only the string values of these parameters are retained.
+	identifierParameterRegexp = regexache.MustCompile(`(Arn|ARN|Id|ID|Identifier|Name|Region)s?$`)
+)
+
+// APIAuditLogConfig is the configuration for the AWS API call audit log.
+type APIAuditLogConfig struct {
+	// Format is the audit log format. Only "jsonl" (one JSON object per line) is supported.
+	Format string
+	// Path is the file to which audit log entries are appended.
+	Path string
+}
+
+// apiAuditLogEntry is a single audit log entry, written once per AWS API call (not per attempt).
+type apiAuditLogEntry struct {
+	DurationMS   int64          `json:"duration_ms"`
+	Error        string         `json:"error,omitempty"`
+	HTTPStatus   int            `json:"http_status,omitempty"`
+	Operation    string         `json:"operation"`
+	Parameters   map[string]any `json:"parameters,omitempty"`
+	Region       string         `json:"region,omitempty"`
+	RequestID    string         `json:"request_id,omitempty"`
+	ResourceID   string         `json:"resource_id,omitempty"`
+	ResourceType string         `json:"resource_type,omitempty"`
+	RetryCount   int            `json:"retry_count"`
+	SDK          string         `json:"sdk"`
+	Service      string         `json:"service"`
+	Time         time.Time      `json:"time"`
+}
+
+var (
+	apiAuditLogs     = make(map[string]*apiAuditLog)
+	apiAuditLogsLock sync.Mutex
+)
+
+// apiAuditLog appends AWS API call audit log entries to a file.
+// All provider instances in a process that are configured with the same path share an apiAuditLog.
+type apiAuditLog struct {
+	file *os.File
+	lock sync.Mutex
+}
+
+func newAPIAuditLog(config *APIAuditLogConfig) (*apiAuditLog, error) {
+	if config == nil {
+		return nil, nil
+	}
+
+	if v := config.Format; v != "" && v != APIAuditLogFormatJSONL {
+		return nil, fmt.Errorf("unsupported API audit log format: %s", v)
+	}
+
+	apiAuditLogsLock.Lock()
+	defer apiAuditLogsLock.Unlock()
+
+	if v, ok := apiAuditLogs[config.Path]; ok {
+		return v, nil
+	}
+
+	file, err := os.OpenFile(config.Path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, err
+	}
+
+	l := &apiAuditLog{
+		file: file,
+	}
+	apiAuditLogs[config.Path] = l
+
+	return l, nil
+}
+
+// write appends an entry to the audit log.
+// Errors are ignored as auditing must never cause an API call to fail.
+func (l *apiAuditLog) write(ctx context.Context, entry *apiAuditLogEntry) {
+	if v, ok := FromContext(ctx); ok {
+		entry.ResourceID = v.ResourceID
+		entry.ResourceType = v.TypeName
+	}
+
+	b, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	b = append(b, '\n')
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	l.file.Write(b) //nolint:errcheck // Best effort.
+}
+
+// addHandlers adds the audit log to the AWS SDK for Go v1 request handlers.
+func (l *apiAuditLog) addHandlers(handlers *request_sdkv1.Handlers) {
+	// Complete handlers are run once per API call, after any retries.
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf.APIAuditLog",
+		Fn: func(r *request_sdkv1.Request) {
+			entry := &apiAuditLogEntry{
+				DurationMS: time.Since(r.Time).Milliseconds(),
+				Parameters: redactParameters(r.Params, false),
+				Region:     aws_sdkv1.StringValue(r.Config.Region),
+				RequestID:  r.RequestID,
+				RetryCount: r.RetryCount,
+				SDK:        "v1",
+				Service:    r.ClientInfo.ServiceID,
+				Time:       r.Time.UTC(),
+			}
+			if r.Operation != nil {
+				entry.Operation = r.Operation.Name
+			}
+			if r.HTTPResponse != nil {
+				entry.HTTPStatus = r.HTTPResponse.StatusCode
+			}
+			if r.Error != nil {
+				if err, ok := r.Error.(awserr.Error); ok {
+					entry.Error = err.Code()
+				} else {
+					entry.Error = r.Error.Error()
+				}
+			}
+
+			l.write(r.Context(), entry)
+		},
+	})
+}
+
+// addMiddleware adds the audit log to an AWS SDK for Go v2 middleware stack.
+// The middleware is added at the end of the Initialize step so that it runs once per API call, outside any retries.
+func (l *apiAuditLog) addMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tf.APIAuditLog", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+
+		out, metadata, err := next.HandleInitialize(ctx, in)
+
+		entry := &apiAuditLogEntry{
+			DurationMS: time.Since(start).Milliseconds(),
+			Operation:  awsmiddleware_sdkv2.GetOperationName(ctx),
+			Parameters: redactParameters(in.Parameters, true),
+			Region:     awsmiddleware_sdkv2.GetRegion(ctx),
+			SDK:        "v2",
+			Service:    awsmiddleware_sdkv2.GetServiceID(ctx),
+			Time:       start.UTC(),
+		}
+		if v, ok := retry_sdkv2.GetAttemptResults(metadata); ok && len(v.Results) > 0 {
+			entry.RetryCount = len(v.Results) - 1
+		}
+		if v, ok := awsmiddleware_sdkv2.GetRawResponse(metadata).(*smithyhttp.Response); ok && v != nil {
+			entry.HTTPStatus = v.StatusCode
+		}
+		if v, ok := awsmiddleware_sdkv2.GetRequestIDMetadata(metadata); ok {
+			entry.RequestID = v
+		}
+		if err != nil {
+			var re *awshttp_sdkv2.ResponseError
+			if errors.As(err, &re) {
+				entry.HTTPStatus = re.HTTPStatusCode()
+				entry.RequestID = re.ServiceRequestID()
+			}
+
+			var ae smithy.APIError
+			if errors.As(err, &ae) {
+				entry.Error = ae.ErrorCode()
+			} else {
+				entry.Error = err.Error()
+			}
+		}
+
+		l.write(ctx, entry)
+
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// redactParameters returns the top-level parameters of an API call's input.
+// Only the values of scalar parameters whose names don't suggest sensitive content are retained.
+// For AWS SDK for Go v2 (sdkv2 == true) plain string values are additionally retained only for identifier parameters.
+func redactParameters(input any, sdkv2 bool) map[string]any {
+	v := reflect.ValueOf(input)
+	for v.Kind() == reflect.Pointer {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Kind() != reflect.Struct {
+		return nil
+	}
+
+	m := make(map[string]any)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		fv := v.Field(i)
+		if fv.IsZero() {
+			continue
+		}
+
+		// AWS SDK for Go v1 marks sensitive fields with a struct tag.
+		if field.Tag.Get("sensitive") == "true" || sensitiveParameterRegexp.MatchString(field.Name) {
+			m[field.Name] = redacted
+			continue
+		}
+
+		for fv.Kind() == reflect.Pointer {
+			fv = fv.Elem()
+		}
+
+		switch fv.Kind() {
+		case reflect.Bool:
+			m[field.Name] = fv.Bool()
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			m[field.Name] = fv.Int()
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			m[field.Name] = fv.Uint()
+		case reflect.Float32, reflect.Float64:
+			m[field.Name] = fv.Float()
+		case reflect.String:
+			// AWS SDK for Go v2 enums are named string types and are always safe to log.
+			if sdkv2 && fv.Type() == reflect.TypeOf("") && !identifierParameterRegexp.MatchString(field.Name) {
+				m[field.Name] = redacted
+			} else {
+				m[field.Name] = fv.String()
+			}
+		default:
+			m[field.Name] = redacted
+		}
+	}
+
+	return m
+}
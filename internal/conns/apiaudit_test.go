@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	ssm_sdkv2 "github.com/aws/aws-sdk-go-v2/service/ssm"
+	ssmtypes "github.com/aws/aws-sdk-go-v2/service/ssm/types"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRedactParameters(t *testing.T) {
+	t.Parallel()
+
+	type input struct {
+		Count          *int64
+		DryRun         *bool
+		Filters        []string
+		Name           *string
+		PolicyDocument *string
+		SecretString   *string `sensitive:"true"`
+		Unset          *string
+		VpcId          *string
+
+		unexported string
+	}
+
+	got := redactParameters(&input{
+		Count:          aws.Int64(3),
+		DryRun:         aws.Bool(true),
+		Filters:        []string{"a", "b"},
+		Name:           aws.String("test"),
+		PolicyDocument: aws.String("{}"),
+		SecretString:   aws.String("s3cr3t"),
+		VpcId:          aws.String("vpc-12345678"),
+		unexported:     "x",
+	}, false)
+	want := map[string]any{
+		"Count":          int64(3),
+		"DryRun":         true,
+		"Filters":        redacted,
+		"Name":           "test",
+		"PolicyDocument": redacted,
+		"SecretString":   redacted,
+		"VpcId":          "vpc-12345678",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+
+	if got := redactParameters(nil, false); got != nil {
+		t.Errorf("nil input: got %v, want nil", got)
+	}
+}
+
+func TestRedactParametersSDKv2(t *testing.T) {
+	t.Parallel()
+
+	got := redactParameters(&ssm_sdkv2.PutParameterInput{
+		DataType:    aws_sdkv2.String("text"),
+		Description: aws_sdkv2.String("database password"),
+		KeyId:       aws_sdkv2.String("alias/example"),
+		Name:        aws_sdkv2.String("/app/db/password"),
+		Overwrite:   aws_sdkv2.Bool(true),
+		Tier:        ssmtypes.ParameterTierStandard,
+		Type:        ssmtypes.ParameterTypeSecureString,
+		Value:       aws_sdkv2.String("s3cr3t"),
+	}, true)
+	want := map[string]any{
+		"DataType":    redacted,
+		"Description": redacted,
+		"KeyId":       redacted,
+		"Name":        "/app/db/password",
+		"Overwrite":   true,
+		"Tier":        "Standard",
+		"Type":        "SecureString",
+		"Value":       redacted,
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+want, -got): %s", diff)
+	}
+}
+
+func TestAPIAuditLogWrite(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+
+	if _, err := newAPIAuditLog(&APIAuditLogConfig{Format: "xml", Path: path}); err == nil {
+		t.Fatal("expected error for unsupported format")
+	}
+
+	l, err := newAPIAuditLog(&APIAuditLogConfig{Format: APIAuditLogFormatJSONL, Path: path})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if other, err := newAPIAuditLog(&APIAuditLogConfig{Path: path}); err != nil {
+		t.Fatal(err)
+	} else if other != l {
+		t.Error("expected audit log to be shared for the same path")
+	}
+
+	ctx := NewResourceContext(context.Background(), "ec2", "VPC", "aws_vpc")
+	inContext, _ := FromContext(ctx)
+	inContext.ResourceID = "vpc-12345678"
+	l.write(ctx, &apiAuditLogEntry{Operation: "CreateVpc", RetryCount: 2, Service: "EC2"})
+	l.write(context.Background(), &apiAuditLogEntry{Operation: "GetCallerIdentity", Service: "STS"})
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var entries []apiAuditLogEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry apiAuditLogEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid JSON line %q: %s", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+
+	if got, want := len(entries), 2; got != want {
+		t.Fatalf("number of entries: got %d, want %d", got, want)
+	}
+	if got, want := entries[0].ResourceType, "aws_vpc"; got != want {
+		t.Errorf("resource type: got %s, want %s", got, want)
+	}
+	if got, want := entries[0].ResourceID, "vpc-12345678"; got != want {
+		t.Errorf("resource ID: got %s, want %s", got, want)
+	}
+	if got, want := entries[0].RetryCount, 2; got != want {
+		t.Errorf("retry count: got %d, want %d", got, want)
+	}
+	if got, want := entries[1].ResourceType, ""; got != want {
+		t.Errorf("resource type: got %s, want %s", got, want)
+	}
+}
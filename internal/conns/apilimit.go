@@ -0,0 +1,281 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultCircuitBreakerCooldown = 30 * time.Second
+)
+
+// APIRateLimitConfig is the provider-wide client-side rate limiting configuration.
+// A single limiter is shared by the AWS SDK for Go v1 and v2 API clients of a service in a Region.
+type APIRateLimitConfig struct {
+	// Burst is the maximum number of requests that can be made at once. Defaults to 1.
+	Burst int
+	// CircuitBreakerCooldown is how long requests are held once the circuit breaker trips.
+	CircuitBreakerCooldown time.Duration
+	// CircuitBreakerThreshold is the number of consecutive throttling errors that trip the circuit breaker.
+	// Zero disables the circuit breaker.
+	CircuitBreakerThreshold int
+	// RequestsPerSecond is the default token bucket refill rate. Zero means unlimited.
+	RequestsPerSecond float64
+	// ServiceRequestsPerSecond overrides RequestsPerSecond for individual service packages.
+	ServiceRequestsPerSecond map[string]float64
+}
+
+func (c *APIRateLimitConfig) requestsPerSecond(servicePackageName string) float64 {
+	if v, ok := c.ServiceRequestsPerSecond[servicePackageName]; ok {
+		return v
+	}
+	return c.RequestsPerSecond
+}
+
+// apiLimiters holds the lazily created API limiters, keyed by service package name and Region.
+type apiLimiters struct {
+	config   *APIRateLimitConfig
+	limiters map[string]*apiLimiter
+	lock     sync.Mutex
+}
+
+func newAPILimiters(config *APIRateLimitConfig) *apiLimiters {
+	if config == nil {
+		return nil
+	}
+
+	return &apiLimiters{
+		config:   config,
+		limiters: make(map[string]*apiLimiter),
+	}
+}
+
+// get returns the API limiter for the specified service package and Region.
+// nil is returned if no rate limiting or circuit breaking is configured for the service.
+func (l *apiLimiters) get(servicePackageName, region string) *apiLimiter {
+	if l == nil {
+		return nil
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	key := fmt.Sprintf("%s/%s", servicePackageName, region)
+	if v, ok := l.limiters[key]; ok {
+		return v
+	}
+
+	limiter := &apiLimiter{
+		name: key,
+	}
+	if v := l.config.requestsPerSecond(servicePackageName); v > 0 {
+		limiter.bucket = newTokenBucket(v, l.config.Burst)
+	}
+	if v := l.config.CircuitBreakerThreshold; v > 0 {
+		cooldown := l.config.CircuitBreakerCooldown
+		if cooldown <= 0 {
+			cooldown = defaultCircuitBreakerCooldown
+		}
+		limiter.breaker = newCircuitBreaker(v, cooldown)
+	}
+	if limiter.bucket == nil && limiter.breaker == nil {
+		limiter = nil
+	}
+
+	l.limiters[key] = limiter
+
+	return limiter
+}
+
+// apiLimiter combines a token bucket rate limiter and a circuit breaker.
+// Every API call attempt, including retries, waits on the limiter.
+type apiLimiter struct {
+	breaker *circuitBreaker
+	bucket  *tokenBucket
+	name    string
+}
+
+// Wait blocks until an API call attempt may proceed or the Context is done.
+func (l *apiLimiter) Wait(ctx context.Context) error {
+	// Don't take a token on behalf of an attempt that will never be made.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	if l.breaker != nil {
+		if d := l.breaker.delay(time.Now()); d > 0 {
+			tflog.Warn(ctx, "API circuit breaker open, holding request", map[string]any{
+				"tf_aws.api_limiter":   l.name,
+				"tf_aws.wait_duration": d.String(),
+			})
+
+			if err := sleepWithContext(ctx, d); err != nil {
+				return err
+			}
+		}
+	}
+
+	if l.bucket != nil {
+		if err := sleepWithContext(ctx, l.bucket.reserve(time.Now())); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Observe records the outcome of an API call attempt.
+func (l *apiLimiter) Observe(ctx context.Context, throttled bool) {
+	if l.breaker == nil {
+		return
+	}
+
+	if l.breaker.observe(time.Now(), throttled) {
+		tflog.Warn(ctx, "API circuit breaker tripped", map[string]any{
+			"tf_aws.api_limiter": l.name,
+			"tf_aws.cooldown":    l.breaker.cooldown.String(),
+		})
+	}
+}
+
+// addHandlers adds the limiter to the AWS SDK for Go v1 request handlers.
+func (l *apiLimiter) addHandlers(handlers *request_sdkv1.Handlers) {
+	// Signing happens once per attempt.
+	handlers.Sign.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf.APILimiter.Wait",
+		Fn: func(r *request_sdkv1.Request) {
+			if err := l.Wait(r.Context()); err != nil {
+				r.Error = err
+			}
+		},
+	})
+	handlers.Retry.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf.APILimiter.ObserveError",
+		Fn: func(r *request_sdkv1.Request) {
+			l.Observe(r.Context(), request_sdkv1.IsErrorThrottle(r.Error))
+		},
+	})
+	handlers.Complete.PushBackNamed(request_sdkv1.NamedHandler{
+		Name: "tf.APILimiter.ObserveSuccess",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Error == nil {
+				l.Observe(r.Context(), false)
+			}
+		},
+	})
+}
+
+// tokenBucket is a simple token bucket rate limiter.
+type tokenBucket struct {
+	burst  float64
+	last   time.Time
+	lock   sync.Mutex
+	rate   float64
+	tokens float64
+}
+
+func newTokenBucket(rate float64, burst int) *tokenBucket {
+	if burst < 1 {
+		burst = 1
+	}
+
+	return &tokenBucket{
+		burst:  float64(burst),
+		rate:   rate,
+		tokens: float64(burst),
+	}
+}
+
+// reserve takes a token from the bucket and returns how long the caller must wait before using it.
+func (b *tokenBucket) reserve(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !b.last.IsZero() {
+		b.tokens += now.Sub(b.last).Seconds() * b.rate
+		if b.tokens > b.burst {
+			b.tokens = b.burst
+		}
+	}
+	b.last = now
+	b.tokens--
+
+	if b.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-b.tokens / b.rate * float64(time.Second))
+}
+
+// circuitBreaker holds all requests for a cooldown period once a threshold of consecutive throttling errors is reached.
+type circuitBreaker struct {
+	consecutive int
+	cooldown    time.Duration
+	lock        sync.Mutex
+	openUntil   time.Time
+	threshold   int
+}
+
+func newCircuitBreaker(threshold int, cooldown time.Duration) *circuitBreaker {
+	return &circuitBreaker{
+		cooldown:  cooldown,
+		threshold: threshold,
+	}
+}
+
+// delay returns how long a request must be held before it may proceed.
+func (b *circuitBreaker) delay(now time.Time) time.Duration {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if now.Before(b.openUntil) {
+		return b.openUntil.Sub(now)
+	}
+
+	return 0
+}
+
+// observe records an attempt's outcome and reports whether the circuit breaker tripped.
+func (b *circuitBreaker) observe(now time.Time, throttled bool) bool {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	if !throttled {
+		b.consecutive = 0
+		return false
+	}
+
+	b.consecutive++
+	if b.consecutive < b.threshold {
+		return false
+	}
+
+	b.consecutive = 0
+	b.openUntil = now.Add(b.cooldown)
+
+	return true
+}
+
+func sleepWithContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+func TestTokenBucketReserve(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newTokenBucket(2, 2)
+
+	// The bucket starts full.
+	for i := 0; i < 2; i++ {
+		if got := b.reserve(now); got != 0 {
+			t.Fatalf("reserve %d: got %s, want 0", i, got)
+		}
+	}
+
+	if got, want := b.reserve(now), 500*time.Millisecond; got != want {
+		t.Errorf("reserve with empty bucket: got %s, want %s", got, want)
+	}
+
+	if got, want := b.reserve(now), 1*time.Second; got != want {
+		t.Errorf("reserve with empty bucket and waiter: got %s, want %s", got, want)
+	}
+
+	// After refilling completely, only burst tokens are available.
+	now = now.Add(10 * time.Second)
+	for i := 0; i < 2; i++ {
+		if got := b.reserve(now); got != 0 {
+			t.Fatalf("reserve %d after refill: got %s, want 0", i, got)
+		}
+	}
+	if got := b.reserve(now); got == 0 {
+		t.Errorf("reserve beyond burst after refill: got 0, want > 0")
+	}
+}
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	b := newCircuitBreaker(3, time.Minute)
+
+	for _, throttled := range []bool{true, true, false, true, true} {
+		if b.observe(now, throttled) {
+			t.Fatal("tripped before reaching threshold of consecutive throttles")
+		}
+	}
+
+	if got := b.delay(now); got != 0 {
+		t.Fatalf("delay with closed breaker: got %s, want 0", got)
+	}
+
+	if !b.observe(now, true) {
+		t.Fatal("did not trip on reaching threshold of consecutive throttles")
+	}
+
+	if got, want := b.delay(now.Add(15*time.Second)), 45*time.Second; got != want {
+		t.Errorf("delay with open breaker: got %s, want %s", got, want)
+	}
+
+	if got := b.delay(now.Add(time.Minute)); got != 0 {
+		t.Errorf("delay after cooldown: got %s, want 0", got)
+	}
+}
+
+func TestAPILimitersGet(t *testing.T) {
+	t.Parallel()
+
+	l := newAPILimiters(&APIRateLimitConfig{
+		RequestsPerSecond: 10,
+		ServiceRequestsPerSecond: map[string]float64{
+			"iam": 0,
+		},
+	})
+
+	ec2 := l.get("ec2", "us-west-2") //lintignore:AWSAT003
+	if ec2 == nil {
+		t.Fatal("expected limiter for ec2")
+	}
+	if got := l.get("ec2", "us-west-2"); got != ec2 { //lintignore:AWSAT003
+		t.Error("expected limiter to be shared within a service and region")
+	}
+	if got := l.get("ec2", "us-east-1"); got == ec2 { //lintignore:AWSAT003
+		t.Error("expected separate limiter per region")
+	}
+	if got := l.get("iam", "us-west-2"); got != nil { //lintignore:AWSAT003
+		t.Error("expected no limiter for service with rate limiting disabled")
+	}
+
+	var none *apiLimiters
+	if got := none.get("ec2", "us-west-2"); got != nil { //lintignore:AWSAT003
+		t.Error("expected no limiter when not configured")
+	}
+}
+
+func TestAPILimiterWaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	l := &apiLimiter{
+		breaker: newCircuitBreaker(1, time.Hour),
+	}
+	l.Observe(context.Background(), true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Wait with open breaker: got %v, want %v", err, context.DeadlineExceeded)
+	}
+}
+
+func TestAPILimiterWaitDoneContext(t *testing.T) {
+	t.Parallel()
+
+	l := &apiLimiter{
+		bucket: newTokenBucket(1, 1),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := l.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("Wait with done Context: got %v, want %v", err, context.Canceled)
+	}
+
+	// No token was taken, so the bucket is still full.
+	if got := l.bucket.reserve(time.Now()); got != 0 {
+		t.Errorf("reserve after Wait with done Context: got %s, want 0", got)
+	}
+}
+
+func TestAddAPILimiter(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	l := &apiLimiter{
+		breaker: newCircuitBreaker(2, time.Hour),
+	}
+	r := addAPILimiter(retry.NewStandard(), l)
+
+	throttle := &smithy.GenericAPIError{Code: "ThrottlingException"}
+	for _, err := range []error{throttle, nil, throttle, throttle} {
+		release, tokenErr := r.GetAttemptToken(ctx)
+		if tokenErr != nil {
+			t.Fatalf("GetAttemptToken: %s", tokenErr)
+		}
+		_ = release(err)
+	}
+
+	if got := l.breaker.delay(time.Now()); got == 0 {
+		t.Fatal("expected circuit breaker to trip on consecutive throttled attempts")
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	cancel()
+
+	if _, err := r.GetAttemptToken(ctx); !errors.Is(err, context.Canceled) {
+		t.Errorf("GetAttemptToken with open breaker and done Context: got %v, want %v", err, context.Canceled)
+	}
+}
@@ -0,0 +1,84 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	awsmiddleware_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	request_sdkv1 "github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/smithy-go/middleware"
+)
+
+const (
+	errCodeReadOnlyOperation = "ReadOnlyOperation"
+)
+
+// readOnlyOperationPrefixes are the prefixes of AWS API operation names that never modify resources.
+var readOnlyOperationPrefixes = []string{
+	"BatchGet",
+	"Describe",
+	"Get",
+	"Head",
+	"List",
+	"Lookup",
+	"Query",
+	"Scan",
+	"Search",
+	"Select",
+}
+
+func isReadOnlyOperation(name string) bool {
+	for _, prefix := range readOnlyOperationPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readOnlyOperationError(serviceID, name string) error {
+	return fmt.Errorf("%s %s: operation not allowed by read-only AWS client", serviceID, name)
+}
+
+// readOnlyExemptFunc returns whether an API call that may modify resources is allowed by a read-only AWS client.
+type readOnlyExemptFunc func(ctx context.Context, serviceID, operation string) bool
+
+// addReadOnlyHandlers rejects any AWS SDK for Go v1 API call that may modify resources, unless exempt.
+func addReadOnlyHandlers(handlers *request_sdkv1.Handlers, exempt readOnlyExemptFunc) {
+	handlers.Validate.PushFrontNamed(request_sdkv1.NamedHandler{
+		Name: "tf.ReadOnly",
+		Fn: func(r *request_sdkv1.Request) {
+			if r.Operation == nil || isReadOnlyOperation(r.Operation.Name) {
+				return
+			}
+
+			if exempt != nil && exempt(r.Context(), r.ClientInfo.ServiceID, r.Operation.Name) {
+				return
+			}
+
+			r.Error = awserr.New(errCodeReadOnlyOperation, readOnlyOperationError(r.ClientInfo.ServiceID, r.Operation.Name).Error(), nil)
+		},
+	})
+}
+
+// addReadOnlyMiddleware returns a function that adds middleware rejecting any AWS SDK for Go v2 API call that may modify resources, unless exempt.
+// The middleware is added at the start of the Initialize step so that no request is ever built or sent.
+func addReadOnlyMiddleware(exempt readOnlyExemptFunc) func(*middleware.Stack) error {
+	return func(stack *middleware.Stack) error {
+		return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("tf.ReadOnly", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+			serviceID, name := awsmiddleware_sdkv2.GetServiceID(ctx), awsmiddleware_sdkv2.GetOperationName(ctx)
+
+			if !isReadOnlyOperation(name) && (exempt == nil || !exempt(ctx, serviceID, name)) {
+				return middleware.InitializeOutput{}, middleware.Metadata{}, readOnlyOperationError(serviceID, name)
+			}
+
+			return next.HandleInitialize(ctx, in)
+		}), middleware.Before)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"testing"
+)
+
+func TestIsReadOnlyOperation(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		expected bool
+	}{
+		{name: "DescribeVpcs", expected: true},
+		{name: "GetCallerIdentity", expected: true},
+		{name: "ListTagsForResource", expected: true},
+		{name: "HeadBucket", expected: true},
+		{name: "BatchGetItem", expected: true},
+		{name: "DeleteVpc"},
+		{name: "TerminateInstances"},
+		{name: "PutBucketPolicy"},
+		{name: "BatchWriteItem"},
+		{name: ""},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := isReadOnlyOperation(testCase.name), testCase.expected; got != want {
+				t.Errorf("isReadOnlyOperation(%q) = %t, want %t", testCase.name, got, want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@
 package conns
 
 import (
+	"context"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/aws/retry"
 )
@@ -16,6 +18,25 @@ func AddIsErrorRetryables(r aws.RetryerV2, retryables ...retry.IsErrorRetryable)
 	}
 }
 
+// wrapRetryer returns a Retryer factory which applies wrap to the Retryers created by retryer.
+// A nil retryer means the AWS SDK for Go v2 standard Retryer.
+func wrapRetryer(retryer func() aws.Retryer, wrap func(aws.RetryerV2) aws.RetryerV2) func() aws.Retryer {
+	return func() aws.Retryer {
+		var r aws.Retryer
+		if retryer != nil {
+			r = retryer()
+		} else {
+			r = retry.NewStandard()
+		}
+
+		if v, ok := r.(aws.RetryerV2); ok {
+			return wrap(v)
+		}
+
+		return r
+	}
+}
+
 type withIsErrorRetryables struct {
 	aws.RetryerV2
 	retryables retry.IsErrorRetryables
@@ -27,3 +48,36 @@ func (r *withIsErrorRetryables) IsErrorRetryable(err error) bool {
 	}
 	return r.RetryerV2.IsErrorRetryable(err)
 }
+
+// addAPILimiter returns a Retryer which waits on the specified API limiter before every API call attempt,
+// including retries, and records whether each attempt was throttled.
+func addAPILimiter(r aws.RetryerV2, limiter *apiLimiter) aws.RetryerV2 {
+	return &withAPILimiter{
+		RetryerV2: r,
+		limiter:   limiter,
+		throttles: retry.IsErrorThrottles(retry.DefaultThrottles),
+	}
+}
+
+type withAPILimiter struct {
+	aws.RetryerV2
+	limiter   *apiLimiter
+	throttles retry.IsErrorThrottles
+}
+
+func (r *withAPILimiter) GetAttemptToken(ctx context.Context) (func(error) error, error) {
+	if err := r.limiter.Wait(ctx); err != nil {
+		return nil, err
+	}
+
+	release, err := r.RetryerV2.GetAttemptToken(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return func(err error) error {
+		r.limiter.Observe(ctx, err != nil && r.throttles.IsErrorThrottle(err) == aws.TrueTernary)
+
+		return release(err)
+	}, nil
+}
@@ -8,9 +8,12 @@ import (
 	"fmt"
 	"net/http"
 	"os"
+	"slices"
 	"sync"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	retry_sdkv2 "github.com/aws/aws-sdk-go-v2/aws/retry"
+	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	endpoints_sdkv1 "github.com/aws/aws-sdk-go/aws/endpoints"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	apigatewayv2_sdkv1 "github.com/aws/aws-sdk-go/service/apigatewayv2"
@@ -21,18 +24,20 @@ import (
 )
 
 type AWSClient struct {
-	AccountID               string
-	DefaultTagsConfig       *tftags.DefaultConfig
-	DNSSuffix               string
-	IgnoreTagsConfig        *tftags.IgnoreConfig
-	MediaConvertAccountConn *mediaconvert_sdkv1.MediaConvert
-	Partition               string
-	Region                  string
-	ReverseDNSPrefix        string
-	ServicePackages         map[string]ServicePackage
-	Session                 *session_sdkv1.Session
-	TerraformVersion        string
-
+	AccountID                    string
+	DefaultTagsConfig            *tftags.DefaultConfig
+	DNSSuffix                    string
+	IgnoreTagsConfig             *tftags.IgnoreConfig
+	MediaConvertAccountConn      *mediaconvert_sdkv1.MediaConvert
+	Partition                    string
+	RetainOnDestroyResourceTypes []string
+	ReverseDNSPrefix             string
+	ServicePackages              map[string]ServicePackage
+	Session                      *session_sdkv1.Session
+	TagPolicyConfig              *tftags.PolicyConfig
+	TerraformVersion             string
+
+	apiLimiters               *apiLimiters
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
@@ -40,6 +45,7 @@ type AWSClient struct {
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
+	region                    string                                    // From provider configuration.
 	s3UsePathStyle            bool                                      // From provider configuration.
 	s3UsEast1RegionalEndpoint endpoints_sdkv1.S3UsEast1RegionalEndpoint // From provider configuration.
 	stsRegion                 string                                    // From provider configuration.
@@ -67,8 +73,8 @@ func (client *AWSClient) PartitionHostname(prefix string) string {
 // RegionalHostname returns a hostname with the provider domain suffix for the region and partition
 // e.g. PREFIX.us-west-2.amazonaws.com
 // The prefix should not contain a trailing period.
-func (client *AWSClient) RegionalHostname(prefix string) string {
-	return fmt.Sprintf("%s.%s.%s", prefix, client.Region, client.DNSSuffix)
+func (client *AWSClient) RegionalHostname(ctx context.Context, prefix string) string {
+	return fmt.Sprintf("%s.%s.%s", prefix, client.Region(ctx), client.DNSSuffix)
 }
 
 // S3UsePathStyle returns the s3_force_path_style provider configuration value.
@@ -76,6 +82,12 @@ func (client *AWSClient) S3UsePathStyle() bool {
 	return client.s3UsePathStyle
 }
 
+// RetainOnDestroy returns whether resources of the specified type are to be removed from state,
+// rather than destroyed, on Delete.
+func (client *AWSClient) RetainOnDestroy(typeName string) bool {
+	return slices.Contains(client.RetainOnDestroyResourceTypes, typeName)
+}
+
 // SetHTTPClient sets the http.Client used for AWS API calls.
 // To have effect it must be called before the AWS SDK v1 Session is created.
 func (client *AWSClient) SetHTTPClient(httpClient *http.Client) {
@@ -89,30 +101,42 @@ func (client *AWSClient) HTTPClient() *http.Client {
 	return client.httpClient
 }
 
+// AddIsErrorRetryables adds retryables which are run on any AWS SDK for Go v2 API client error.
+// To have effect it must be called before any AWS SDK for Go v2 API clients are created.
+func (client *AWSClient) AddIsErrorRetryables(retryables ...retry_sdkv2.IsErrorRetryable) {
+	if client.awsConfig == nil {
+		return
+	}
+
+	client.awsConfig.Retryer = wrapRetryer(client.awsConfig.Retryer, func(r aws_sdkv2.RetryerV2) aws_sdkv2.RetryerV2 {
+		return AddIsErrorRetryables(r, retryables...)
+	})
+}
+
 // RegisterLogger places the configured logger into Context so it can be used via `tflog`.
 func (client *AWSClient) RegisterLogger(ctx context.Context) context.Context {
 	return baselogging.RegisterLogger(ctx, client.logger)
 }
 
-// APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the configured AWS Region.
+// APIGatewayInvokeURL returns the Amazon API Gateway (REST APIs) invoke URL for the AWS Region in Context.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/how-to-call-api.html.
-func (client *AWSClient) APIGatewayInvokeURL(restAPIID, stageName string) string {
-	return fmt.Sprintf("https://%s/%s", client.RegionalHostname(fmt.Sprintf("%s.execute-api", restAPIID)), stageName)
+func (client *AWSClient) APIGatewayInvokeURL(ctx context.Context, restAPIID, stageName string) string {
+	return fmt.Sprintf("https://%s/%s", client.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", restAPIID)), stageName)
 }
 
-// APIGatewayV2InvokeURL returns the Amazon API Gateway v2 (WebSocket & HTTP APIs) invoke URL for the configured AWS Region.
+// APIGatewayV2InvokeURL returns the Amazon API Gateway v2 (WebSocket & HTTP APIs) invoke URL for the AWS Region in Context.
 // See https://docs.aws.amazon.com/apigateway/latest/developerguide/http-api-publish.html and
 // https://docs.aws.amazon.com/apigateway/latest/developerguide/apigateway-set-up-websocket-deployment.html.
-func (client *AWSClient) APIGatewayV2InvokeURL(protocolType, apiID, stageName string) string {
+func (client *AWSClient) APIGatewayV2InvokeURL(ctx context.Context, protocolType, apiID, stageName string) string {
 	if protocolType == apigatewayv2_sdkv1.ProtocolTypeWebsocket {
-		return fmt.Sprintf("wss://%s/%s", client.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)), stageName)
+		return fmt.Sprintf("wss://%s/%s", client.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)), stageName)
 	}
 
 	if stageName == "$default" {
-		return fmt.Sprintf("https://%s/", client.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)))
+		return fmt.Sprintf("https://%s/", client.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)))
 	}
 
-	return fmt.Sprintf("https://%s/%s", client.RegionalHostname(fmt.Sprintf("%s.execute-api", apiID)), stageName)
+	return fmt.Sprintf("https://%s/%s", client.RegionalHostname(ctx, fmt.Sprintf("%s.execute-api", apiID)), stageName)
 }
 
 // CloudFrontDistributionHostedZoneID returns the Route 53 hosted zone ID
@@ -151,13 +175,41 @@ func (client *AWSClient) GlobalAcceleratorHostedZoneID() string {
 	return "Z2BJ6XQ5FK7U4H" // See https://docs.aws.amazon.com/general/latest/gr/global_accelerator.html#global_accelerator_region
 }
 
-// apiClientConfig returns the AWS API client configuration parameters for the specified service.
-func (client *AWSClient) apiClientConfig(servicePackageName string) map[string]any {
+// Region returns the AWS Region for the specified Context.
+// This is the resource's or data source's Region override, if any, otherwise the provider's configured Region.
+func (client *AWSClient) Region(ctx context.Context) string {
+	if v, ok := FromContext(ctx); ok && v.Region != "" {
+		return v.Region
+	}
+
+	return client.region
+}
+
+// apiClientConfig returns the AWS API client configuration parameters for the specified service and Region.
+func (client *AWSClient) apiClientConfig(servicePackageName, region string) map[string]any {
+	awsConfig, session := client.awsConfig, client.Session
+	limiter := client.apiLimiters.get(servicePackageName, region)
+	if region != client.region || limiter != nil {
+		cfg := client.awsConfig.Copy()
+		awsConfig = &cfg
+		awsConfig.Region = region
+		session = client.Session.Copy(&aws_sdkv1.Config{Region: aws_sdkv1.String(region)})
+
+		if limiter != nil {
+			// The AWS SDK for Go v1 and v2 API clients share the limiter.
+			// AWS SDK for Go v2 API clients wait on the limiter via their Retryer, which is consulted before every attempt.
+			awsConfig.Retryer = wrapRetryer(awsConfig.Retryer, func(r aws_sdkv2.RetryerV2) aws_sdkv2.RetryerV2 {
+				return addAPILimiter(r, limiter)
+			})
+			limiter.addHandlers(&session.Handlers)
+		}
+	}
+
 	m := map[string]any{
-		"aws_sdkv2_config": client.awsConfig,
+		"aws_sdkv2_config": awsConfig,
 		"endpoint":         client.endpoints[servicePackageName],
 		"partition":        client.Partition,
-		"session":          client.Session,
+		"session":          session,
 	}
 	switch servicePackageName {
 	case names.S3:
@@ -182,7 +234,10 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.conns[servicePackageName]; ok {
+	region := c.Region(ctx)
+	key := apiClientCacheKey(servicePackageName, region, c.region)
+
+	if raw, ok := c.conns[key]; ok {
 		if conn, ok := raw.(T); ok {
 			return conn, nil
 		} else {
@@ -205,7 +260,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		return zero, fmt.Errorf("no AWS SDK v1 API client factory: %s", servicePackageName)
 	}
 
-	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName))
+	conn, err := v.NewConn(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -221,7 +276,7 @@ func conn[T any](ctx context.Context, c *AWSClient, servicePackageName string) (
 		}
 	}
 
-	c.conns[servicePackageName] = conn
+	c.conns[key] = conn
 
 	return conn, nil
 }
@@ -231,7 +286,10 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 	c.lock.Lock()
 	defer c.lock.Unlock()
 
-	if raw, ok := c.clients[servicePackageName]; ok {
+	region := c.Region(ctx)
+	key := apiClientCacheKey(servicePackageName, region, c.region)
+
+	if raw, ok := c.clients[key]; ok {
 		if client, ok := raw.(T); ok {
 			return client, nil
 		} else {
@@ -254,7 +312,7 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 		return zero, fmt.Errorf("no AWS SDK v2 API client factory: %s", servicePackageName)
 	}
 
-	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName))
+	client, err := v.NewClient(ctx, c.apiClientConfig(servicePackageName, region))
 	if err != nil {
 		var zero T
 		return zero, err
@@ -262,7 +320,17 @@ func client[T any](ctx context.Context, c *AWSClient, servicePackageName string)
 
 	// All customization for AWS SDK for Go v2 API clients must be done during construction.
 
-	c.clients[servicePackageName] = client
+	c.clients[key] = client
 
 	return client, nil
 }
+
+// apiClientCacheKey returns the key under which an API client for the specified service and Region is cached.
+// Clients for the provider's configured Region are cached by service package name alone.
+func apiClientCacheKey(servicePackageName, region, defaultRegion string) string {
+	if region == defaultRegion {
+		return servicePackageName
+	}
+
+	return servicePackageName + "/" + region
+}
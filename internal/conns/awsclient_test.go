@@ -4,6 +4,7 @@
 package conns
 
 import (
+	"context"
 	"testing"
 )
 
@@ -61,7 +62,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS Commercial",
 			AWSClient: &AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    "us-west-2", //lintignore:AWSAT003
+				region:    "us-west-2", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.us-west-2.amazonaws.com", //lintignore:AWSAT003
@@ -70,7 +71,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 			Name: "AWS China",
 			AWSClient: &AWSClient{
 				DNSSuffix: "amazonaws.com.cn",
-				Region:    "cn-northwest-1", //lintignore:AWSAT003
+				region:    "cn-northwest-1", //lintignore:AWSAT003
 			},
 			Prefix:   "test",
 			Expected: "test.cn-northwest-1.amazonaws.com.cn", //lintignore:AWSAT003
@@ -82,7 +83,7 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		t.Run(testCase.Name, func(t *testing.T) {
 			t.Parallel()
 
-			got := testCase.AWSClient.RegionalHostname(testCase.Prefix)
+			got := testCase.AWSClient.RegionalHostname(context.Background(), testCase.Prefix)
 
 			if got != testCase.Expected {
 				t.Errorf("got %s, expected %s", got, testCase.Expected)
@@ -90,3 +91,36 @@ func TestAWSClientRegionalHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-n
 		})
 	}
 }
+
+func TestAWSClientRegion(t *testing.T) { // nosemgrep:ci.aws-in-func-name
+	t.Parallel()
+
+	client := &AWSClient{
+		DNSSuffix: "amazonaws.com",
+		region:    "us-west-2", //lintignore:AWSAT003
+	}
+
+	ctx := context.Background()
+	if got, want := client.Region(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("no resource context: got %s, expected %s", got, want)
+	}
+
+	ctx = NewResourceContext(ctx, "Test", "Test", "aws_test")
+	if got, want := client.Region(ctx), "us-west-2"; got != want { //lintignore:AWSAT003
+		t.Errorf("no Region override: got %s, expected %s", got, want)
+	}
+
+	inContext, _ := FromContext(ctx)
+	inContext.Region = "eu-central-1"                                 //lintignore:AWSAT003
+	if got, want := client.Region(ctx), "eu-central-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region override: got %s, expected %s", got, want)
+	}
+
+	if got, want := client.RegionalHostname(ctx, "test"), "test.eu-central-1.amazonaws.com"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region override hostname: got %s, expected %s", got, want)
+	}
+
+	if got, want := apiClientCacheKey("ec2", client.Region(ctx), client.region), "ec2/eu-central-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("API client cache key: got %s, expected %s", got, want)
+	}
+}
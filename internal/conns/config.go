@@ -25,6 +25,8 @@ import (
 
 type Config struct {
 	AccessKey                      string
+	APIAuditLog                    *APIAuditLogConfig
+	APIRateLimit                   *APIRateLimitConfig
 	AllowedAccountIds              []string
 	AssumeRole                     *awsbase.AssumeRole
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
@@ -39,10 +41,14 @@ type Config struct {
 	HTTPSProxy                     *string
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
+	LocalEndpoint                  string
 	MaxRetries                     int
 	NoProxy                        string
 	Profile                        string
+	ReadOnly                       bool
+	ReadOnlyExempt                 func(ctx context.Context, serviceID, operation string) bool
 	Region                         string
+	RetainOnDestroyResourceTypes   []string
 	RetryMode                      aws_sdkv2.RetryMode
 	S3UsePathStyle                 bool
 	S3UsEast1RegionalEndpoint      endpoints_sdkv1.S3UsEast1RegionalEndpoint
@@ -54,6 +60,7 @@ type Config struct {
 	SkipRequestingAccountId        bool
 	STSRegion                      string
 	SuppressDebugLog               bool
+	TagPolicyConfig                *tftags.PolicyConfig
 	TerraformVersion               string
 	Token                          string
 	UseDualStackEndpoint           bool
@@ -66,6 +73,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	if c.LocalEndpoint != "" {
+		c.configureLocalEndpoint(ctx)
+	}
+
 	awsbaseConfig := awsbase.Config{
 		AccessKey:                     c.AccessKey,
 		AllowedAccountIds:             c.AllowedAccountIds,
@@ -167,6 +178,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
+	if c.APIAuditLog != nil {
+		auditLog, err := newAPIAuditLog(c.APIAuditLog)
+		if err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "opening API audit log (%s): %s", c.APIAuditLog.Path, err)
+		}
+
+		// Every AWS SDK for Go v1 and v2 API client inherits the audit log.
+		cfg.APIOptions = append(cfg.APIOptions, auditLog.addMiddleware)
+		auditLog.addHandlers(&sess.Handlers)
+	}
+
+	if c.ReadOnly {
+		// Every AWS SDK for Go v1 and v2 API client inherits the restriction.
+		cfg.APIOptions = append(cfg.APIOptions, addReadOnlyMiddleware(c.ReadOnlyExempt))
+		addReadOnlyHandlers(&sess.Handlers, c.ReadOnlyExempt)
+	}
+
 	tflog.Debug(ctx, "Retrieving AWS account details")
 	accountID, partition, awsDiags := awsbase.GetAwsAccountIDAndPartition(ctx, cfg, &awsbaseConfig)
 	for _, d := range awsDiags {
@@ -198,13 +226,16 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.DNSSuffix = DNSSuffix
 	client.IgnoreTagsConfig = c.IgnoreTagsConfig
 	client.Partition = partition
-	client.Region = c.Region
+	client.region = c.Region
+	client.RetainOnDestroyResourceTypes = c.RetainOnDestroyResourceTypes
 	client.ReverseDNSPrefix = ReverseDNS(DNSSuffix)
+	client.TagPolicyConfig = c.TagPolicyConfig
 	client.SetHTTPClient(sess.Config.HTTPClient) // Must be called while client.Session is nil.
 	client.Session = sess
 	client.TerraformVersion = c.TerraformVersion
 
 	// Used for lazy-loading AWS API clients.
+	client.apiLimiters = newAPILimiters(c.APIRateLimit)
 	client.awsConfig = &cfg
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
@@ -217,6 +248,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	return client, diags
 }
 
+// configureLocalEndpoint targets every AWS service at a local AWS endpoint emulator.
+// Any explicitly configured service endpoint takes precedence.
+func (c *Config) configureLocalEndpoint(ctx context.Context) {
+	tflog.Info(ctx, "Targeting local AWS endpoint emulator", map[string]any{
+		"tf_aws.local_endpoint": c.LocalEndpoint,
+	})
+
+	if c.Endpoints == nil {
+		c.Endpoints = make(map[string]string)
+	}
+
+	for _, pkg := range names.ProviderPackages() {
+		if c.Endpoints[pkg] == "" {
+			c.Endpoints[pkg] = c.LocalEndpoint
+		}
+	}
+
+	// Emulators accept any credentials and Region and serve S3 buckets by path.
+	c.S3UsePathStyle = true
+	c.SkipCredsValidation = true
+	c.SkipRegionValidation = true
+}
+
 func baseSeverityToSdkSeverity(s basediag.Severity) diag.Severity {
 	switch s {
 	case basediag.SeverityWarning:
@@ -508,3 +508,43 @@ func TestProxyConfig(t *testing.T) { //nolint:paralleltest // uses t.Setenv
 		})
 	}
 }
+
+func TestLocalEndpoint(t *testing.T) { //nolint:paralleltest // uses t.Setenv
+	ctx := context.Background()
+
+	servicemocks.InitSessionTestEnv(t)
+
+	ts := servicemocks.MockAwsApiServer("STS", []*servicemocks.MockEndpoint{
+		servicemocks.MockStsGetCallerIdentityValidEndpoint,
+	})
+	defer ts.Close()
+
+	p, err := provider.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	provider.SetLocalEndpoint(p, ts.URL)
+
+	config := map[string]any{
+		"access_key": servicemocks.MockStaticAccessKey,
+		"secret_key": servicemocks.MockStaticSecretKey,
+		"region":     "us-west-2",
+	}
+
+	diags := p.Configure(ctx, terraformsdk.NewResourceConfigRaw(config))
+
+	if diff := cmp.Diff(diags, diag.Diagnostics(nil), cmp.Comparer(sdkdiag.Comparer)); diff != "" {
+		t.Errorf("unexpected diagnostics difference: %s", diff)
+	}
+
+	meta := p.Meta().(*conns.AWSClient)
+
+	if got, want := meta.AccountID, servicemocks.MockStsGetCallerIdentityAccountID; got != want {
+		t.Errorf("AccountID: got %s, want %s", got, want)
+	}
+
+	if !meta.S3UsePathStyle() {
+		t.Error("S3UsePathStyle: got false, want true")
+	}
+}
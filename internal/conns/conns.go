@@ -7,6 +7,7 @@ import (
 	"context"
 	"strings"
 
+	"github.com/YakDriver/regexache"
 	aws_sdkv1 "github.com/aws/aws-sdk-go/aws"
 	session_sdkv1 "github.com/aws/aws-sdk-go/aws/session"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
@@ -25,35 +26,60 @@ type ServicePackage interface {
 	ServicePackageName() string
 }
 
+// ServicePackageWithEphemeralResources is an interface that extends ServicePackage with ephemeral resources.
+// Ephemeral resources are never persisted in Terraform state.
+type ServicePackageWithEphemeralResources interface {
+	ServicePackage
+	EphemeralResources(context.Context) []*types.ServicePackageEphemeralResource
+}
+
 type (
 	contextKeyType int
 )
 
 var (
-	contextKey contextKeyType
+	contextKey   contextKeyType
+	regionRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
 )
 
 // InContext represents the resource information kept in Context.
 type InContext struct {
 	IsDataSource       bool   // Data source?
+	IsEphemeral        bool   // Ephemeral resource?
+	Region             string // Per-resource Region override, if any
+	ResourceID         string // Resource ID, if known when the operation started
 	ResourceName       string // Friendly resource name, e.g. "Subnet"
 	ServicePackageName string // Canonical name defined as a constant in names package
+	TypeName           string // Terraform type name, e.g. "aws_subnet"
 }
 
-func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewDataSourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		IsDataSource:       true,
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
+	}
+
+	return context.WithValue(ctx, contextKey, &v)
+}
+
+func NewEphemeralResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
+	v := InContext{
+		IsEphemeral:        true,
+		ResourceName:       resourceName,
+		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
 }
 
-func NewResourceContext(ctx context.Context, servicePackageName, resourceName string) context.Context {
+func NewResourceContext(ctx context.Context, servicePackageName, resourceName, typeName string) context.Context {
 	v := InContext{
 		ResourceName:       resourceName,
 		ServicePackageName: servicePackageName,
+		TypeName:           typeName,
 	}
 
 	return context.WithValue(ctx, contextKey, &v)
@@ -64,6 +90,22 @@ func FromContext(ctx context.Context) (*InContext, bool) {
 	return v, ok
 }
 
+// ParseRegionalImportID splits an import ID of the form "<id>@<region>" into its constituent parts.
+// ok is false if the import ID has no valid Region suffix.
+func ParseRegionalImportID(id string) (string, string, bool) {
+	i := strings.LastIndex(id, "@")
+	if i < 0 {
+		return id, "", false
+	}
+
+	region := id[i+1:]
+	if !regionRegexp.MatchString(region) {
+		return id, "", false
+	}
+
+	return id[:i], region, true
+}
+
 func NewSessionForRegion(cfg *aws_sdkv1.Config, region, terraformVersion string) (*session_sdkv1.Session, error) {
 	session, err := session_sdkv1.NewSession(cfg)
 
@@ -53,3 +53,59 @@ func TestReverseDNS(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRegionalImportID(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		input          string
+		expectedID     string
+		expectedRegion string
+		expectedOK     bool
+	}{
+		{
+			name:       "no suffix",
+			input:      "vpc-12345678",
+			expectedID: "vpc-12345678",
+		},
+		{
+			name:           "Region suffix",
+			input:          "vpc-12345678@eu-west-2", //lintignore:AWSAT003
+			expectedID:     "vpc-12345678",
+			expectedRegion: "eu-west-2", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+		{
+			name:       "invalid Region suffix",
+			input:      "user@example.com",
+			expectedID: "user@example.com",
+		},
+		{
+			name:           "multiple separators",
+			input:          "user@example.com@us-gov-west-1", //lintignore:AWSAT003
+			expectedID:     "user@example.com",
+			expectedRegion: "us-gov-west-1", //lintignore:AWSAT003
+			expectedOK:     true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, region, ok := ParseRegionalImportID(testCase.input)
+
+			if got, want := id, testCase.expectedID; got != want {
+				t.Errorf("id got: %s, expected: %s", got, want)
+			}
+			if got, want := region, testCase.expectedRegion; got != want {
+				t.Errorf("region got: %s, expected: %s", got, want)
+			}
+			if got, want := ok, testCase.expectedOK; got != want {
+				t.Errorf("ok got: %t, expected: %t", got, want)
+			}
+		})
+	}
+}
@@ -66,6 +66,10 @@ const (
 	// For tests requiring restricted IAM permissions, an existing IAM Role to assume
 	// An inline assume role policy is then used to deny actions for the test
 	AccAssumeRoleARN = "TF_ACC_ASSUME_ROLE_ARN"
+
+	// For tests run against a local AWS endpoint emulator, the emulator's URL
+	// Every service endpoint targets the emulator and credential validation is skipped
+	LocalEndpoint = "TF_ACC_LOCAL_ENDPOINT"
 )
 
 // Custom environment variables used for assuming a role with resource sweepers
@@ -84,6 +88,34 @@ const (
 	AssumeRoleSessionName = "TF_AWS_ASSUME_ROLE_SESSION_NAME"
 )
 
+// Custom environment variables used to filter and report on resource sweepers
+const (
+	// Lists the resources that would be swept without deleting them.
+	// AWS API calls that may modify resources are rejected.
+	SweepDryRun = "TF_AWS_SWEEP_DRY_RUN"
+
+	// Comma-separated name prefixes; only resources whose name begins with one of them are swept
+	SweepNamePrefixes = "TF_AWS_SWEEP_NAME_PREFIXES"
+
+	// Comma-separated name prefixes; resources whose name begins with one of them are never swept
+	SweepExcludeNamePrefixes = "TF_AWS_SWEEP_EXCLUDE_NAME_PREFIXES"
+
+	// Comma-separated tags, as key=value or key; only resources with all of them are swept
+	SweepTags = "TF_AWS_SWEEP_TAGS"
+
+	// Comma-separated tags, as key=value or key; resources with any of them are never swept
+	SweepExcludeTags = "TF_AWS_SWEEP_EXCLUDE_TAGS"
+
+	// Path of a JSON report of swept and skipped resources per region
+	SweepReport = "TF_AWS_SWEEP_REPORT"
+
+	// Path of a Graphviz DOT file to which the sweeper dependency graph is written
+	SweepGraph = "TF_AWS_SWEEP_GRAPH"
+
+	// Maximum number of sweepers run concurrently in a region; defaults to 10
+	SweepConcurrency = "TF_AWS_SWEEP_CONCURRENCY"
+)
+
 // GetWithDefault gets an environment variable value if non-empty or returns the default.
 func GetWithDefault(variable string, defaultValue string) string {
 	value := os.Getenv(variable)
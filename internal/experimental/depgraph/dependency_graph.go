@@ -5,6 +5,7 @@ package depgraph
 
 import (
 	"fmt"
+	"io"
 	"strings"
 
 	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
@@ -185,6 +186,58 @@ func (g *Graph) OverallOrder() ([]string, error) {
 	return order, nil
 }
 
+// Levels returns the nodes of the dependency graph grouped so that every node's dependencies are in an earlier group.
+// Nodes in the same group do not depend on each other and can be processed concurrently.
+// Returns an error if a dependency cycle is detected.
+func (g *Graph) Levels() ([][]string, error) {
+	order, err := g.OverallOrder()
+	if err != nil {
+		return nil, err
+	}
+
+	levels := make([][]string, 0)
+	levelOf := make(map[string]int)
+
+	// In the overall order every node appears after all of its dependencies.
+	for _, node := range order {
+		level := 0
+		for _, dependency := range g.outgoingEdges[node] {
+			if v := levelOf[dependency] + 1; v > level {
+				level = v
+			}
+		}
+
+		levelOf[node] = level
+		if level == len(levels) {
+			levels = append(levels, make([]string, 0))
+		}
+		levels[level] = append(levels[level], node)
+	}
+
+	return levels, nil
+}
+
+// WriteDOT writes the dependency graph in Graphviz DOT format to the specified writer.
+// Each edge points from a node to one of its dependencies.
+func (g *Graph) WriteDOT(w io.Writer, name string) error {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "digraph %q {\n", name)
+	for _, node := range g.nodes {
+		fmt.Fprintf(&sb, "\t%q;\n", node)
+	}
+	for _, node := range g.nodes {
+		for _, dependency := range g.outgoingEdges[node] {
+			fmt.Fprintf(&sb, "\t%q -> %q;\n", node, dependency)
+		}
+	}
+	sb.WriteString("}\n")
+
+	_, err := io.WriteString(w, sb.String())
+
+	return err
+}
+
 // depthFirstSearch returns a Topological Sort using Depth-First-Search on a set of edges.
 // Returns an error if a dependency cycle is detected.
 func depthFirstSearch(edges map[string][]string) func(s string) ([]string, error) {
@@ -5,6 +5,7 @@ package depgraph
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
@@ -417,3 +418,96 @@ func TestDependencyGraphOverallOrder(t *testing.T) {
 		t.Fatalf("incorrect overall order. Expected: %v, got: %v", expected, got)
 	}
 }
+
+func TestDependencyGraphLevels(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+
+	got, err := g.Levels()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if expected := [][]string{}; !reflect.DeepEqual(got, expected) {
+		t.Fatalf("incorrect levels. Expected: %v, got: %v", expected, got)
+	}
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+	g.AddNode("d")
+	g.AddNode("e")
+
+	err = g.AddDependency("a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("a", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("b", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("c", "d")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	got, err = g.Levels()
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	expected := [][]string{{"d", "e"}, {"b", "c"}, {"a"}}
+	if diff := cmp.Diff(got, expected); diff != "" {
+		t.Errorf("incorrect levels. Unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	err = g.AddDependency("d", "a")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	_, err = g.Levels()
+	if err == nil {
+		t.Fatalf("expected error")
+	}
+}
+
+func TestDependencyGraphWriteDOT(t *testing.T) {
+	t.Parallel()
+
+	g := New()
+
+	g.AddNode("a")
+	g.AddNode("b")
+	g.AddNode("c")
+
+	err := g.AddDependency("a", "b")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	err = g.AddDependency("a", "c")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var sb strings.Builder
+	err = g.WriteDOT(&sb, "test")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	expected := `digraph "test" {
+	"a";
+	"b";
+	"c";
+	"a" -> "b";
+	"a" -> "c";
+}
+`
+	if diff := cmp.Diff(sb.String(), expected); diff != "" {
+		t.Errorf("incorrect DOT. Unexpected diff (+wanted, -got): %s", diff)
+	}
+}
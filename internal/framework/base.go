@@ -10,6 +10,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,11 +30,12 @@ func (w *withMeta) Meta() *conns.AWSClient {
 }
 
 // RegionalARN returns a regional ARN for the specified service namespace and resource.
-func (w *withMeta) RegionalARN(service, resource string) string {
+// The ARN is in the AWS Region for the specified Context.
+func (w *withMeta) RegionalARN(ctx context.Context, service, resource string) string {
 	return arn.ARN{
 		Partition: w.meta.Partition,
 		Service:   service,
-		Region:    w.meta.Region,
+		Region:    w.meta.Region(ctx),
 		AccountID: w.meta.AccountID,
 		Resource:  resource,
 	}.String()
@@ -122,6 +124,19 @@ func (d *DataSourceWithConfigure) Configure(_ context.Context, request datasourc
 	}
 }
 
+// EphemeralResourceWithConfigure is a structure to be embedded within an EphemeralResource that implements the EphemeralResourceWithConfigure interface.
+type EphemeralResourceWithConfigure struct {
+	withMeta
+}
+
+// Configure enables provider-level data or clients to be set in the
+// provider-defined EphemeralResource type.
+func (r *EphemeralResourceWithConfigure) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+}
+
 // WithTimeouts is intended to be embedded in resources which use the special "timeouts" nested block.
 // See https://developer.hashicorp.com/terraform/language/resources/syntax#operation-timeouts.
 type WithTimeouts struct {
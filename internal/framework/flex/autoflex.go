@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"reflect"
 	"strings"
+	"time"
 
 	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -36,7 +37,7 @@ func Expand(ctx context.Context, tfObject, apiObject any, optFns ...AutoFlexOpti
 	expander := &autoExpander{}
 
 	for _, optFn := range optFns {
-		optFn(expander)
+		optFn(&expander.Options)
 	}
 
 	diags.Append(autoFlexConvert(ctx, tfObject, apiObject, expander)...)
@@ -59,7 +60,7 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 	flattener := &autoFlattener{}
 
 	for _, optFn := range optFns {
-		optFn(flattener)
+		optFn(&flattener.Options)
 	}
 
 	diags.Append(autoFlexConvert(ctx, apiObject, tfObject, flattener)...)
@@ -74,14 +75,73 @@ func Flatten(ctx context.Context, apiObject, tfObject any, optFns ...AutoFlexOpt
 // autoFlexer is the interface implemented by an auto-flattener or expander.
 type autoFlexer interface {
 	convert(context.Context, reflect.Value, reflect.Value) diag.Diagnostics
+	options() AutoFlexOptions
+}
+
+// AutoFlexOptions holds the options for an auto-flattener or expander.
+type AutoFlexOptions struct {
+	IgnoredFieldNames []string // Names of source fields that are not copied.
 }
 
 // AutoFlexOptionsFunc is a type alias for an autoFlexer functional option.
-type AutoFlexOptionsFunc func(autoFlexer)
+type AutoFlexOptionsFunc func(*AutoFlexOptions)
 
-type autoExpander struct{}
+// WithIgnoredFieldNames ignores the specified source fields.
+func WithIgnoredFieldNames(fieldNames ...string) AutoFlexOptionsFunc {
+	return func(o *AutoFlexOptions) {
+		o.IgnoredFieldNames = append(o.IgnoredFieldNames, fieldNames...)
+	}
+}
+
+func (o AutoFlexOptions) isIgnoredField(fieldName string) bool {
+	for _, v := range o.IgnoredFieldNames {
+		if v == fieldName {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Expander is implemented by Plugin Framework data structures that expand themselves,
+// for example into a member of an AWS API union.
+type Expander interface {
+	Expand(ctx context.Context) (any, diag.Diagnostics)
+}
+
+// Flattener is implemented by Plugin Framework data structures that flatten themselves,
+// for example from a member of an AWS API union.
+type Flattener interface {
+	Flatten(ctx context.Context, v any) diag.Diagnostics
+}
 
-type autoFlattener struct{}
+type autoExpander struct {
+	Options AutoFlexOptions
+}
+
+func (expander autoExpander) options() AutoFlexOptions {
+	return expander.Options
+}
+
+type autoFlattener struct {
+	Options AutoFlexOptions
+}
+
+func (flattener autoFlattener) options() AutoFlexOptions {
+	return flattener.Options
+}
+
+const (
+	// autoFlexTagKey is the struct tag key used to customize the mapping of a Plugin Framework data structure's field.
+	// `autoflex:"-"` ignores the field and `autoflex:"Name"` maps the field to the AWS API field named Name.
+	autoFlexTagKey = "autoflex"
+	// autoFlexTagIgnore is the struct tag value used to ignore a field.
+	autoFlexTagIgnore = "-"
+)
+
+var (
+	timeType = reflect.TypeOf(time.Time{})
+)
 
 // autoFlexConvert converts `from` to `to` using the specified auto-flexer.
 func autoFlexConvert(ctx context.Context, from, to any, flexer autoFlexer) diag.Diagnostics {
@@ -146,7 +206,18 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 		if fieldName == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		toFieldVal := findFieldFuzzy(ctx, fieldName, valTo)
+		if flexer.options().isIgnoredField(fieldName) {
+			continue
+		}
+		var toFieldVal reflect.Value
+		switch tag := field.Tag.Get(autoFlexTagKey); tag {
+		case autoFlexTagIgnore:
+			continue
+		case "":
+			toFieldVal = findFieldFuzzy(ctx, fieldName, valTo)
+		default:
+			toFieldVal = valTo.FieldByName(tag)
+		}
 		if !toFieldVal.IsValid() {
 			continue // Corresponding field not found in to.
 		}
@@ -164,8 +235,15 @@ func autoFlexConvertStruct(ctx context.Context, from any, to any, flexer autoFle
 }
 
 func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo reflect.Value) reflect.Value {
+	// zeroth precedence is an explicit mapping via struct tag
+	for i, typTo := 0, valTo.Type(); i < typTo.NumField(); i++ {
+		if tag := typTo.Field(i).Tag.Get(autoFlexTagKey); tag == fieldNameFrom {
+			return valTo.Field(i)
+		}
+	}
+
 	// first precedence is exact match (case sensitive)
-	if v := valTo.FieldByName(fieldNameFrom); v.IsValid() {
+	if v := fieldByName(valTo, fieldNameFrom); v.IsValid() {
 		return v
 	}
 
@@ -179,7 +257,7 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo reflect.Val
 		if fieldNameTo == "Tags" {
 			continue // Resource tags are handled separately.
 		}
-		if v := valTo.FieldByName(fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) {
+		if v := fieldByName(valTo, fieldNameTo); v.IsValid() && strings.EqualFold(fieldNameFrom, fieldNameTo) {
 			// probably could assume validity here since reflect gave the field name
 			return v
 		}
@@ -187,13 +265,13 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo reflect.Val
 
 	// third precedence is singular/plural
 	if plural.IsSingular(fieldNameFrom) {
-		if v := valTo.FieldByName(plural.Plural(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Plural(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
 
 	if plural.IsPlural(fieldNameFrom) {
-		if v := valTo.FieldByName(plural.Singular(fieldNameFrom)); v.IsValid() {
+		if v := fieldByName(valTo, plural.Singular(fieldNameFrom)); v.IsValid() {
 			return v
 		}
 	}
@@ -211,7 +289,21 @@ func findFieldFuzzy(ctx context.Context, fieldNameFrom string, valTo reflect.Val
 	}
 
 	// no finds, fuzzy or otherwise - return invalid
-	return valTo.FieldByName(fieldNameFrom)
+	return reflect.Value{}
+}
+
+// fieldByName returns the struct field with the given name.
+// Fields that are ignored or explicitly mapped via struct tag are not returned.
+func fieldByName(valTo reflect.Value, fieldName string) reflect.Value {
+	field, ok := valTo.Type().FieldByName(fieldName)
+	if !ok {
+		return reflect.Value{}
+	}
+	if field.Tag.Get(autoFlexTagKey) != "" {
+		return reflect.Value{}
+	}
+
+	return valTo.FieldByIndex(field.Index)
 }
 
 // convert converts a single Plugin Framework value to its AWS API equivalent.
@@ -414,14 +506,44 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 		vTo.SetString(v.ValueString())
 		return diags
 
+	case reflect.Struct:
+		if vTo.Type() == timeType {
+			//
+			// fwtypes.Timestamp -> time.Time.
+			//
+			t, d := expander.timestamp(ctx, v)
+			diags.Append(d...)
+			if diags.HasError() {
+				return diags
+			}
+
+			vTo.Set(reflect.ValueOf(t))
+			return diags
+		}
+
 	case reflect.Ptr:
-		switch vTo.Type().Elem().Kind() {
+		switch tElem := vTo.Type().Elem(); tElem.Kind() {
 		case reflect.String:
 			//
 			// types.String -> *string.
 			//
 			vTo.Set(reflect.ValueOf(v.ValueStringPointer()))
 			return diags
+
+		case reflect.Struct:
+			if tElem == timeType {
+				//
+				// fwtypes.Timestamp -> *time.Time.
+				//
+				t, d := expander.timestamp(ctx, v)
+				diags.Append(d...)
+				if diags.HasError() {
+					return diags
+				}
+
+				vTo.Set(reflect.ValueOf(&t))
+				return diags
+			}
 		}
 	}
 
@@ -433,6 +555,19 @@ func (expander autoExpander) string(ctx context.Context, vFrom basetypes.StringV
 	return diags
 }
 
+// timestamp parses a Plugin Framework RFC3339 String(ish) value.
+func (expander autoExpander) timestamp(_ context.Context, v basetypes.StringValue) (time.Time, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	t, err := time.Parse(time.RFC3339, v.ValueString())
+	if err != nil {
+		diags.AddError("AutoFlEx", fmt.Sprintf("parsing timestamp %q: %s", v.ValueString(), err))
+		return time.Time{}, diags
+	}
+
+	return t, diags
+}
+
 // list copies a Plugin Framework List(ish) value to a compatible AWS API value.
 func (expander autoExpander) list(ctx context.Context, vFrom basetypes.ListValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -480,7 +615,7 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSlice(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -509,6 +644,24 @@ func (expander autoExpander) listOfString(ctx context.Context, vFrom basetypes.L
 	return diags
 }
 
+// stringSlice returns a slice of the specified type, e.g. []awstypes.Enum, containing the specified strings.
+func stringSlice(from []string, tSlice reflect.Type) reflect.Value {
+	if tSlice.Elem() == reflect.TypeOf("") {
+		return reflect.ValueOf(from)
+	}
+
+	if from == nil {
+		return reflect.Zero(tSlice)
+	}
+
+	to := reflect.MakeSlice(tSlice, len(from), len(from))
+	for i, v := range from {
+		to.Index(i).SetString(v)
+	}
+
+	return to
+}
+
 // map_ copies a Plugin Framework Map(ish) value to a compatible AWS API value.
 func (expander autoExpander) map_(ctx context.Context, vFrom basetypes.MapValuable, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -634,7 +787,7 @@ func (expander autoExpander) setOfString(ctx context.Context, vFrom basetypes.Se
 				return diags
 			}
 
-			vTo.Set(reflect.ValueOf(to))
+			vTo.Set(stringSlice(to, vTo.Type()))
 			return diags
 
 		case reflect.Ptr:
@@ -682,6 +835,13 @@ func (expander autoExpander) nestedObject(ctx context.Context, vFrom fwtypes.Nes
 			return diags
 		}
 
+	case reflect.Interface:
+		//
+		// types.List(OfObject) -> interface, e.g. an AWS API union.
+		//
+		diags.Append(expander.nestedObjectToInterface(ctx, vFrom, tTo, vTo)...)
+		return diags
+
 	case reflect.Slice:
 		switch tElem := tTo.Elem(); tElem.Kind() {
 		case reflect.Struct:
@@ -735,6 +895,44 @@ func (expander autoExpander) nestedObjectToStruct(ctx context.Context, vFrom fwt
 	return diags
 }
 
+// nestedObjectToInterface copies a Plugin Framework NestedObjectValue to a compatible AWS API interface value.
+// The nested Object must implement Expander.
+func (expander autoExpander) nestedObjectToInterface(ctx context.Context, vFrom fwtypes.NestedObjectValue, tInterface reflect.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Get the nested Object as a pointer.
+	from, d := vFrom.ToObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	v, ok := from.(Expander)
+	if !ok {
+		diags.AddError("Incompatible types", fmt.Sprintf("%T does not implement Expander", from))
+		return diags
+	}
+
+	to, d := v.Expand(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if to == nil {
+		return diags
+	}
+
+	if t := reflect.TypeOf(to); !t.Implements(tInterface) {
+		diags.AddError("Incompatible types", fmt.Sprintf("%s does not implement %s", t, tInterface))
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(to))
+
+	return diags
+}
+
 // nestedObjectToSlice copies a Plugin Framework NestedObjectValue to a compatible AWS API [](*)struct value.
 func (expander autoExpander) nestedObjectToSlice(ctx context.Context, vFrom fwtypes.NestedObjectValue, tSlice, tElem reflect.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -883,10 +1081,21 @@ func (flattener autoFlattener) convert(ctx context.Context, vFrom, vTo reflect.V
 		return diags
 
 	case reflect.Struct:
+		if vFrom.Type() == timeType {
+			diags.Append(flattener.timestamp(ctx, vFrom, false, tTo, vTo)...)
+			return diags
+		}
+
 		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
 			diags.Append(flattener.structToNestedObject(ctx, vFrom, tTo, vTo)...)
 			return diags
 		}
+
+	case reflect.Interface:
+		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
+			diags.Append(flattener.interfaceToNestedObject(ctx, vFrom, tTo, vTo)...)
+			return diags
+		}
 	}
 
 	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
@@ -998,7 +1207,10 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 	case basetypes.StringTypable:
 		stringValue := types.StringNull()
 		if !isNullFrom {
-			stringValue = types.StringValue(vFrom.String())
+			// The zero value of an AWS API enum type, e.g. awstypes.Status(""), is considered null by a StringEnum.
+			if _, ok := tTo.(fwtypes.StringEnumTyper); !ok || vFrom.String() != "" {
+				stringValue = types.StringValue(vFrom.String())
+			}
 		}
 		v, d := tTo.ValueFromString(ctx, stringValue)
 		diags.Append(d...)
@@ -1021,6 +1233,39 @@ func (flattener autoFlattener) string(ctx context.Context, vFrom reflect.Value,
 	return diags
 }
 
+// timestamp copies an AWS API time.Time value to a compatible Plugin Framework value.
+func (flattener autoFlattener) timestamp(ctx context.Context, vFrom reflect.Value, isNullFrom bool, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tTo := tTo.(type) {
+	case basetypes.StringTypable:
+		stringValue := types.StringNull()
+		if !isNullFrom {
+			if t := vFrom.Interface().(time.Time); !t.IsZero() {
+				stringValue = types.StringValue(t.Format(time.RFC3339))
+			}
+		}
+		v, d := tTo.ValueFromString(ctx, stringValue)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		//
+		// time.Time -> fwtypes.Timestamp.
+		//
+		vTo.Set(reflect.ValueOf(v))
+		return diags
+	}
+
+	tflog.Info(ctx, "AutoFlex Flatten; incompatible types", map[string]interface{}{
+		"from": vFrom.Type(),
+		"to":   tTo,
+	})
+
+	return diags
+}
+
 // ptr copies an AWS API pointer value to a compatible Plugin Framework value.
 func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo attr.Type, vTo reflect.Value) diag.Diagnostics {
 	var diags diag.Diagnostics
@@ -1043,6 +1288,14 @@ func (flattener autoFlattener) ptr(ctx context.Context, vFrom reflect.Value, tTo
 		return diags
 
 	case reflect.Struct:
+		if vFrom.Type().Elem() == timeType {
+			//
+			// *time.Time -> fwtypes.Timestamp.
+			//
+			diags.Append(flattener.timestamp(ctx, vElem, isNilFrom, tTo, vTo)...)
+			return diags
+		}
+
 		if tTo, ok := tTo.(fwtypes.NestedObjectType); ok {
 			//
 			// *struct -> types.List(OfObject).
@@ -1489,3 +1742,76 @@ func (flattener autoFlattener) sliceOfStructNestedObject(ctx context.Context, vF
 	vTo.Set(reflect.ValueOf(val))
 	return diags
 }
+
+// interfaceToNestedObject copies an AWS API interface value, e.g. an AWS API union, to a compatible Plugin Framework NestedObjectValue value.
+// If the target structure implements Flattener it is used to flatten the interface value, otherwise the union member's
+// Value field is copied to the target structure's field corresponding to the member name, e.g. the value of
+// awstypes.FilterMemberName is copied to the Name field.
+func (flattener autoFlattener) interfaceToNestedObject(ctx context.Context, vFrom reflect.Value, tTo fwtypes.NestedObjectType, vTo reflect.Value) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if vFrom.IsNil() {
+		val, d := tTo.NullValue(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		vTo.Set(reflect.ValueOf(val))
+		return diags
+	}
+
+	// Create a new target structure.
+	to, d := tTo.NewObjectPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v, ok := to.(Flattener); ok {
+		diags.Append(v.Flatten(ctx, vFrom.Interface())...)
+		if diags.HasError() {
+			return diags
+		}
+	} else {
+		vMember := vFrom.Elem()
+		if vMember.Kind() == reflect.Ptr {
+			vMember = vMember.Elem()
+		}
+
+		typeName := vMember.Type().Name()
+		i := strings.Index(typeName, "Member")
+		if vMember.Kind() != reflect.Struct || i < 0 {
+			diags.AddError("Incompatible types", fmt.Sprintf("%s is not a union member", vMember.Type()))
+			return diags
+		}
+
+		vValue := vMember.FieldByName("Value")
+		if !vValue.IsValid() {
+			diags.AddError("Incompatible types", fmt.Sprintf("union member %s has no Value field", vMember.Type()))
+			return diags
+		}
+
+		memberName := typeName[i+len("Member"):]
+		toFieldVal := findFieldFuzzy(ctx, memberName, reflect.ValueOf(to).Elem())
+		if !toFieldVal.IsValid() || !toFieldVal.CanSet() {
+			diags.AddError("Incompatible types", fmt.Sprintf("%T has no field corresponding to union member %s", to, vMember.Type()))
+			return diags
+		}
+
+		diags.Append(flattener.convert(ctx, vValue, toFieldVal)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	// Set the target structure as a nested Object.
+	val, d := tTo.ValueFromObjectPtr(ctx, to)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	vTo.Set(reflect.ValueOf(val))
+	return diags
+}
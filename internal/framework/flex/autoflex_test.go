@@ -6,10 +6,12 @@ package flex
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/google/go-cmp/cmp"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
@@ -1512,3 +1514,309 @@ func TestGenericFlattenCustomStringType(t *testing.T) {
 		})
 	}
 }
+
+type TestEnum string
+
+// Enum values for TestEnum
+const (
+	TestEnumScalar TestEnum = "Scalar"
+	TestEnumList   TestEnum = "List"
+)
+
+func (TestEnum) Values() []TestEnum {
+	return []TestEnum{
+		TestEnumScalar,
+		TestEnumList,
+	}
+}
+
+// TestFlexAWSUnion is an AWS API union.
+type TestFlexAWSUnion interface {
+	isTestFlexAWSUnion()
+}
+
+type TestFlexAWSUnionMemberName struct {
+	Value string
+}
+
+func (*TestFlexAWSUnionMemberName) isTestFlexAWSUnion() {}
+
+type TestFlexAWSUnionMemberCount struct {
+	Value int64
+}
+
+func (*TestFlexAWSUnionMemberCount) isTestFlexAWSUnion() {}
+
+type TestFlexTFUnion struct {
+	Name  types.String `tfsdk:"name"`
+	Count types.Int64  `tfsdk:"count"`
+}
+
+func (m *TestFlexTFUnion) Expand(ctx context.Context) (any, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	switch {
+	case !m.Name.IsNull():
+		return &TestFlexAWSUnionMemberName{Value: m.Name.ValueString()}, diags
+	case !m.Count.IsNull():
+		return &TestFlexAWSUnionMemberCount{Value: m.Count.ValueInt64()}, diags
+	}
+
+	return nil, diags
+}
+
+type TestFlexTF19 struct {
+	Field1 fwtypes.ListNestedObjectValueOf[TestFlexTFUnion] `tfsdk:"field1"`
+}
+
+type TestFlexAWS19 struct {
+	Field1 TestFlexAWSUnion
+}
+
+type TestFlexTF20 struct {
+	Field1 fwtypes.Timestamp `tfsdk:"field1"`
+	Field2 fwtypes.Timestamp `tfsdk:"field2"`
+}
+
+type TestFlexAWS20 struct {
+	Field1 time.Time
+	Field2 *time.Time
+}
+
+type TestFlexTF21 struct {
+	Field1 fwtypes.StringEnum[TestEnum] `tfsdk:"field1"`
+	Field2 types.List                   `tfsdk:"field2"`
+}
+
+type TestFlexAWS21 struct {
+	Field1 TestEnum
+	Field2 []TestEnum
+}
+
+type TestFlexTF22 struct {
+	Name    types.String `tfsdk:"name" autoflex:"ResourceName"`
+	Ignored types.String `tfsdk:"ignored" autoflex:"-"`
+	Field1  types.String `tfsdk:"field1"`
+}
+
+type TestFlexAWS22 struct {
+	ResourceName *string
+	Name         *string
+	Ignored      *string
+	Field1       string
+}
+
+type TestFlexTF23 struct {
+	Field1 types.String `tfsdk:"field1"`
+}
+
+func TestGenericExpandExtended(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testTime := time.Date(2023, time.October, 1, 12, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		TestName   string
+		Options    []AutoFlexOptionsFunc
+		Source     any
+		Target     any
+		WantErr    bool
+		WantTarget any
+	}{
+		{
+			TestName:   "union Source and interface Target",
+			Source:     &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTFUnion{Name: types.StringValue("a")})},
+			Target:     &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{Field1: &TestFlexAWSUnionMemberName{Value: "a"}},
+		},
+		{
+			TestName:   "union Source with other member and interface Target",
+			Source:     &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTFUnion{Count: types.Int64Value(42)})},
+			Target:     &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{Field1: &TestFlexAWSUnionMemberCount{Value: 42}},
+		},
+		{
+			TestName:   "null union Source and interface Target",
+			Source:     &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTFUnion](ctx)},
+			Target:     &TestFlexAWS19{},
+			WantTarget: &TestFlexAWS19{},
+		},
+		{
+			TestName: "non-Expander Source and interface Target",
+			Source:   &TestFlexTF05{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTF01{Field1: types.StringValue("a")})},
+			Target:   &struct{ Field1 TestFlexAWSUnion }{},
+			WantErr:  true,
+		},
+		{
+			TestName:   "Timestamp Source and time.Time Target",
+			Source:     &TestFlexTF20{Field1: fwtypes.TimestampValue("2023-10-01T12:30:00Z"), Field2: fwtypes.TimestampValue("2023-10-01T12:30:00Z")},
+			Target:     &TestFlexAWS20{},
+			WantTarget: &TestFlexAWS20{Field1: testTime, Field2: &testTime},
+		},
+		{
+			TestName:   "null Timestamp Source and time.Time Target",
+			Source:     &TestFlexTF20{Field1: fwtypes.TimestampNull(), Field2: fwtypes.TimestampNull()},
+			Target:     &TestFlexAWS20{},
+			WantTarget: &TestFlexAWS20{},
+		},
+		{
+			TestName: "StringEnum Source and enum Target",
+			Source: &TestFlexTF21{
+				Field1: fwtypes.StringEnumValue(TestEnumList),
+				Field2: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("Scalar"),
+					types.StringValue("List"),
+				}),
+			},
+			Target:     &TestFlexAWS21{},
+			WantTarget: &TestFlexAWS21{Field1: TestEnumList, Field2: []TestEnum{TestEnumScalar, TestEnumList}},
+		},
+		{
+			TestName: "struct tags",
+			Source: &TestFlexTF22{
+				Name:    types.StringValue("a"),
+				Ignored: types.StringValue("b"),
+				Field1:  types.StringValue("c"),
+			},
+			Target:     &TestFlexAWS22{},
+			WantTarget: &TestFlexAWS22{ResourceName: aws.String("a"), Field1: "c"},
+		},
+		{
+			TestName: "ignored field names",
+			Options:  []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field1")},
+			Source: &TestFlexTF22{
+				Name:   types.StringValue("a"),
+				Field1: types.StringValue("c"),
+			},
+			Target:     &TestFlexAWS22{},
+			WantTarget: &TestFlexAWS22{ResourceName: aws.String("a")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := Expand(ctx, testCase.Source, testCase.Target, testCase.Options...)
+			gotErr := err != nil
+
+			if gotErr != testCase.WantErr {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.WantErr)
+			}
+
+			if gotErr {
+				if !testCase.WantErr {
+					t.Errorf("err = %q", err)
+				}
+			} else if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestGenericFlattenExtended(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testTime := time.Date(2023, time.October, 1, 12, 30, 0, 0, time.UTC)
+	testCases := []struct {
+		TestName   string
+		Options    []AutoFlexOptionsFunc
+		Source     any
+		Target     any
+		WantErr    bool
+		WantTarget any
+	}{
+		{
+			TestName:   "union Source and union Target",
+			Source:     &TestFlexAWS19{Field1: &TestFlexAWSUnionMemberName{Value: "a"}},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTFUnion{Name: types.StringValue("a"), Count: types.Int64Null()})},
+		},
+		{
+			TestName:   "union Source with other member and union Target",
+			Source:     &TestFlexAWS19{Field1: &TestFlexAWSUnionMemberCount{Value: 42}},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfPtr(ctx, &TestFlexTFUnion{Name: types.StringNull(), Count: types.Int64Value(42)})},
+		},
+		{
+			TestName:   "nil union Source and union Target",
+			Source:     &TestFlexAWS19{},
+			Target:     &TestFlexTF19{},
+			WantTarget: &TestFlexTF19{Field1: fwtypes.NewListNestedObjectValueOfNull[TestFlexTFUnion](ctx)},
+		},
+		{
+			TestName:   "time.Time Source and Timestamp Target",
+			Source:     &TestFlexAWS20{Field1: testTime, Field2: &testTime},
+			Target:     &TestFlexTF20{},
+			WantTarget: &TestFlexTF20{Field1: fwtypes.TimestampValue("2023-10-01T12:30:00Z"), Field2: fwtypes.TimestampValue("2023-10-01T12:30:00Z")},
+		},
+		{
+			TestName:   "zero time.Time Source and Timestamp Target",
+			Source:     &TestFlexAWS20{},
+			Target:     &TestFlexTF20{},
+			WantTarget: &TestFlexTF20{Field1: fwtypes.TimestampNull(), Field2: fwtypes.TimestampNull()},
+		},
+		{
+			TestName: "enum Source and StringEnum Target",
+			Source:   &TestFlexAWS21{Field1: TestEnumList, Field2: []TestEnum{TestEnumScalar, TestEnumList}},
+			Target:   &TestFlexTF21{},
+			WantTarget: &TestFlexTF21{
+				Field1: fwtypes.StringEnumValue(TestEnumList),
+				Field2: types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("Scalar"),
+					types.StringValue("List"),
+				}),
+			},
+		},
+		{
+			TestName:   "zero enum Source and StringEnum Target",
+			Source:     &TestFlexAWS21{},
+			Target:     &TestFlexTF21{},
+			WantTarget: &TestFlexTF21{Field1: fwtypes.StringEnumNull[TestEnum](), Field2: types.ListNull(types.StringType)},
+		},
+		{
+			TestName:   "zero enum Source and String Target",
+			Source:     &TestFlexAWS21{},
+			Target:     &TestFlexTF23{},
+			WantTarget: &TestFlexTF23{Field1: types.StringValue("")},
+		},
+		{
+			TestName:   "struct tags",
+			Source:     &TestFlexAWS22{ResourceName: aws.String("a"), Name: aws.String("x"), Ignored: aws.String("b"), Field1: "c"},
+			Target:     &TestFlexTF22{},
+			WantTarget: &TestFlexTF22{Name: types.StringValue("a"), Field1: types.StringValue("c")},
+		},
+		{
+			TestName:   "ignored field names",
+			Options:    []AutoFlexOptionsFunc{WithIgnoredFieldNames("Field1")},
+			Source:     &TestFlexAWS22{ResourceName: aws.String("a"), Field1: "c"},
+			Target:     &TestFlexTF22{},
+			WantTarget: &TestFlexTF22{Name: types.StringValue("a")},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.TestName, func(t *testing.T) {
+			t.Parallel()
+
+			err := Flatten(ctx, testCase.Source, testCase.Target, testCase.Options...)
+			gotErr := err != nil
+
+			if gotErr != testCase.WantErr {
+				t.Errorf("gotErr = %v, wantErr = %v", gotErr, testCase.WantErr)
+			}
+
+			if gotErr {
+				if !testCase.WantErr {
+					t.Errorf("err = %q", err)
+				}
+			} else if diff := cmp.Diff(testCase.Target, testCase.WantTarget); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -63,6 +63,12 @@ type stringEnumTypeWithAttributeDefault[T enum.Valueser[T]] interface {
 	AttributeDefault(T) defaults.String
 }
 
+// StringEnumTyper is implemented by every StringEnumType, whatever its enum.
+type StringEnumTyper interface {
+	basetypes.StringTypable
+	stringEnumType()
+}
+
 type stringEnumType[T enum.Valueser[T]] struct {
 	customStringTypeWithValidator
 }
@@ -141,6 +147,8 @@ func (t stringEnumType[T]) AttributeDefault(defaultVal T) defaults.String {
 	return stringdefault.StaticString(string(defaultVal))
 }
 
+func (stringEnumType[T]) stringEnumType() {}
+
 func StringEnumNull[T enum.Valueser[T]]() StringEnum[T] {
 	return StringEnum[T]{StringValue: basetypes.NewStringNull()}
 }
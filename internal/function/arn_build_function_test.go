@@ -0,0 +1,70 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNBuildFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		partition, service, region, accountID, resource string
+		expected                                        string
+	}{
+		"global": {
+			partition: "aws",
+			service:   "iam",
+			accountID: "444455556666",
+			resource:  "role/example",
+			expected:  "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+		},
+		"regional": {
+			partition: "aws",
+			service:   "rds",
+			region:    "us-east-1", // lintignore:AWSAT003
+			accountID: "123456789012",
+			resource:  "db:test",
+			expected:  "arn:aws:rds:us-east-1:123456789012:db:test", // lintignore:AWSAT003,AWSAT005
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{
+					types.StringValue(testCase.partition),
+					types.StringValue(testCase.service),
+					types.StringValue(testCase.region),
+					types.StringValue(testCase.accountID),
+					types.StringValue(testCase.resource),
+				}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			tffunction.NewARNBuildFunction().Run(ctx, req, &resp)
+
+			if resp.Error != nil {
+				t.Fatalf("unexpected error: %s", resp.Error)
+			}
+
+			if got, want := resp.Result.Value(), types.StringValue(testCase.expected); !got.Equal(want) {
+				t.Errorf("got %s, want %s", got, want)
+			}
+		})
+	}
+}
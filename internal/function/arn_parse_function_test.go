@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestARNParseFunction(t *testing.T) {
+	t.Parallel()
+
+	attrTypes := map[string]attr.Type{
+		"partition":  types.StringType,
+		"service":    types.StringType,
+		"region":     types.StringType,
+		"account_id": types.StringType,
+		"resource":   types.StringType,
+	}
+
+	testCases := map[string]struct {
+		arn           string
+		expected      attr.Value
+		expectedError bool
+	}{
+		"valid ARN": {
+			arn: "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("iam"),
+				"region":     types.StringValue(""),
+				"account_id": types.StringValue("444455556666"),
+				"resource":   types.StringValue("role/example"),
+			}),
+		},
+		"regional ARN": {
+			arn: "arn:aws:rds:us-east-1:123456789012:db:test", // lintignore:AWSAT003,AWSAT005
+			expected: types.ObjectValueMust(attrTypes, map[string]attr.Value{
+				"partition":  types.StringValue("aws"),
+				"service":    types.StringValue("rds"),
+				"region":     types.StringValue("us-east-1"), // lintignore:AWSAT003
+				"account_id": types.StringValue("123456789012"),
+				"resource":   types.StringValue("db:test"),
+			}),
+		},
+		"invalid ARN": {
+			arn:           "invalid",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.arn)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.ObjectUnknown(attrTypes)),
+			}
+
+			tffunction.NewARNParseFunction().Run(ctx, req, &resp)
+
+			if got, want := resp.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Run() error = %v, expected error: %t", resp.Error, want)
+			}
+
+			if resp.Error == nil {
+				if diff := cmp.Diff(resp.Result.Value(), testCase.expected); diff != "" {
+					t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+				}
+			}
+		})
+	}
+}
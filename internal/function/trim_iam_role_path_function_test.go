@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package function_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tffunction "github.com/hashicorp/terraform-provider-aws/internal/function"
+)
+
+func TestTrimIAMRolePathFunction(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		arn           string
+		expected      string
+		expectedError bool
+	}{
+		"no path": {
+			arn:      "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example", // lintignore:AWSAT005
+		},
+		"single path element": {
+			arn:      "arn:aws:iam::444455556666:role/with/example", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example",      // lintignore:AWSAT005
+		},
+		"multiple path elements": {
+			arn:      "arn:aws:iam::444455556666:role/with/multiple/paths/example", // lintignore:AWSAT005
+			expected: "arn:aws:iam::444455556666:role/example",                     // lintignore:AWSAT005
+		},
+		"invalid ARN": {
+			arn:           "invalid",
+			expectedError: true,
+		},
+		"not a role": {
+			arn:           "arn:aws:iam::444455556666:user/example", // lintignore:AWSAT005
+			expectedError: true,
+		},
+		"not IAM": {
+			arn:           "arn:aws:s3:::role/example", // lintignore:AWSAT005
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		name, testCase := name, testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			req := function.RunRequest{
+				Arguments: function.NewArgumentsData([]attr.Value{types.StringValue(testCase.arn)}),
+			}
+			resp := function.RunResponse{
+				Result: function.NewResultData(types.StringUnknown()),
+			}
+
+			tffunction.NewTrimIAMRolePathFunction().Run(ctx, req, &resp)
+
+			if got, want := resp.Error != nil, testCase.expectedError; got != want {
+				t.Fatalf("Run() error = %v, expected error: %t", resp.Error, want)
+			}
+
+			if resp.Error == nil {
+				if got, want := resp.Result.Value(), types.StringValue(testCase.expected); !got.Equal(want) {
+					t.Errorf("got %s, want %s", got, want)
+				}
+			}
+		})
+	}
+}
@@ -13,18 +13,23 @@ import (
 	"fmt"
 	"go/ast"
 	"go/format"
+	"go/types"
 	"html/template"
 	"log"
 	"os"
 	"sort"
 	"strings"
 
+	pluralize "github.com/gertd/go-pluralize"
 	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/tools/go/packages"
 )
 
 const (
 	defaultFilename = "list_pages_gen.go"
+
+	sdkV1 = 1
+	sdkV2 = 2
 )
 
 var (
@@ -33,6 +38,8 @@ var (
 	outputPaginator = flag.String("OutputPaginator", "", "name of the output pagination token field")
 	paginator       = flag.String("Paginator", "NextToken", "name of the pagination token field")
 	export          = flag.Bool("Export", false, "whether to export the list functions")
+	notFoundError   = flag.String("NotFoundError", "", "name of the AWS SDK for Go v2 error type returned when the listed resource is not found")
+	sdkVersion      = flag.Int("AWSSDKVersion", sdkV1, "Version of the AWS Go SDK to use i.e. 1 or 2")
 )
 
 func usage() {
@@ -67,6 +74,18 @@ func main() {
 	servicePackage := os.Getenv("GOPACKAGE")
 	log.SetPrefix(fmt.Sprintf("generate/listpage: %s: ", servicePackage))
 
+	switch *sdkVersion {
+	case sdkV1:
+		if *notFoundError != "" {
+			log.Fatal("NotFoundError is only supported with AWS SDK for Go v2")
+		}
+	case sdkV2:
+		generateV2(filename, servicePackage)
+		return
+	default:
+		log.Fatalf("AWS SDK Go Version %d not supported", *sdkVersion)
+	}
+
 	awsService, err := names.AWSGoV1Package(servicePackage)
 
 	if err != nil {
@@ -364,3 +383,211 @@ func fixSomeInitialisms(s string) string {
 
 	return replace
 }
+
+// generateV2 generates finder functions for AWS SDK for Go v2 paginated list operations.
+func generateV2(filename, servicePackage string) {
+	awsService, err := names.AWSGoV2Package(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	sourcePackage := fmt.Sprintf("github.com/aws/aws-sdk-go-v2/service/%[1]s", awsService)
+	typesPackage := sourcePackage + "/types"
+
+	cfg := &packages.Config{
+		Mode: packages.NeedName | packages.NeedTypes,
+	}
+	pkgs, err := packages.Load(cfg, sourcePackage, typesPackage)
+	if err != nil {
+		log.Fatal(err)
+	}
+	var pkg, typesPkg *packages.Package
+	for _, v := range pkgs {
+		switch v.PkgPath {
+		case sourcePackage:
+			pkg = v
+		case typesPackage:
+			typesPkg = v
+		}
+	}
+	if pkg == nil || typesPkg == nil {
+		log.Fatalf("error: %d packages found", len(pkgs))
+	}
+
+	awsServiceUpper, err := names.ProviderNameUpper(servicePackage)
+
+	if err != nil {
+		log.Fatalf("encountered: %s", err)
+	}
+
+	headerInfo := HeaderInfoV2{
+		Parameters:         strings.Join(os.Args[1:], " "),
+		DestinationPackage: servicePackage,
+		SourcePackage:      sourcePackage,
+		TypesPackage:       typesPackage,
+		NotFoundError:      *notFoundError,
+	}
+
+	var funcSpecs []FuncSpecV2
+	listOps := strings.Split(*listOps, ",")
+	sort.Strings(listOps)
+
+	for _, listOp := range listOps {
+		// Each list operation is <operation-name>[:<items-field-name>].
+		opName, itemsField, _ := strings.Cut(listOp, ":")
+		funcSpec := newFuncSpecV2(pkg, opName, itemsField, awsServiceUpper, *export)
+
+		if strings.Contains(funcSpec.ItemType, "awstypes.") {
+			headerInfo.ImportTypes = true
+		}
+
+		funcSpecs = append(funcSpecs, funcSpec)
+	}
+
+	if headerInfo.NotFoundError != "" {
+		if typesPkg.Types.Scope().Lookup(headerInfo.NotFoundError) == nil {
+			log.Fatalf("error type \"%s\" not found", headerInfo.NotFoundError)
+		}
+		headerInfo.ImportTypes = true
+	}
+
+	var buf bytes.Buffer
+
+	if err := template.Must(template.New("header").Parse(headerV2Template)).Execute(&buf, headerInfo); err != nil {
+		log.Fatalf("error writing header: %s", err)
+	}
+
+	tmpl := template.Must(template.New("function").Parse(functionV2Template))
+
+	for _, funcSpec := range funcSpecs {
+		if err := tmpl.Execute(&buf, funcSpec); err != nil {
+			log.Fatalf("error writing function \"%s\": %s", funcSpec.AWSName, err)
+		}
+	}
+
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Printf("warning: internal error: invalid Go generated: %s", err)
+		log.Printf("warning: compile the package to analyze the error")
+		src = buf.Bytes()
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		log.Fatalf("error writing output: %s", err)
+	}
+}
+
+type HeaderInfoV2 struct {
+	Parameters         string
+	DestinationPackage string
+	SourcePackage      string
+	TypesPackage       string
+	ImportTypes        bool
+	NotFoundError      string
+}
+
+type FuncSpecV2 struct {
+	Name          string // e.g. findWidgets
+	SingularName  string // e.g. findWidget
+	AWSName       string // e.g. ListWidgets
+	AWSPackage    string // e.g. widgets
+	ItemsField    string // e.g. Widgets
+	ItemType      string // e.g. awstypes.Widget
+	ItemIsPointer bool
+	NotFoundError string
+}
+
+var (
+	plural = pluralize.NewClient()
+)
+
+// newFuncSpecV2 returns the specification of the finder functions for the specified AWS SDK for Go v2 list operation.
+// The operation must have a paginator. If no items field is specified, the operation's output must have a single slice field.
+func newFuncSpecV2(pkg *packages.Package, opName, itemsField, awsServiceUpper string, export bool) FuncSpecV2 {
+	scope := pkg.Types.Scope()
+
+	if scope.Lookup(fmt.Sprintf("New%sPaginator", opName)) == nil {
+		log.Fatalf("paginator for \"%s\" not found", opName)
+	}
+
+	obj := scope.Lookup(opName + "Output")
+	if obj == nil {
+		log.Fatalf("output type for \"%s\" not found", opName)
+	}
+
+	output, ok := obj.Type().Underlying().(*types.Struct)
+	if !ok {
+		log.Fatalf("output type for \"%s\" is not a struct", opName)
+	}
+
+	var items *types.Var
+	for i := 0; i < output.NumFields(); i++ {
+		field := output.Field(i)
+		if _, ok := field.Type().(*types.Slice); !ok || !field.Exported() {
+			continue
+		}
+
+		if itemsField == "" {
+			if items != nil {
+				log.Fatalf("output type for \"%s\" has multiple slice fields, specify one as %[1]s:<field-name>", opName)
+			}
+			items = field
+		} else if field.Name() == itemsField {
+			items = field
+		}
+	}
+
+	if items == nil {
+		log.Fatalf("items field for \"%s\" not found", opName)
+	}
+
+	// Qualify AWS SDK for Go v2 types with the "awstypes" import alias.
+	qualifier := func(p *types.Package) string {
+		if p.Path() == pkg.PkgPath+"/types" {
+			return "awstypes"
+		}
+		return p.Name()
+	}
+	elem := items.Type().(*types.Slice).Elem()
+	ptr, isPointer := elem.(*types.Pointer)
+	if isPointer {
+		elem = ptr.Elem()
+	}
+
+	// e.g. "DescribeVpcEndpointServices" -> "VPCEndpointServices".
+	name := opName
+	for _, prefix := range []string{"Describe", "Get", "List", "Search"} {
+		if v := strings.TrimPrefix(name, prefix); v != name && v != "" {
+			name = v
+			break
+		}
+	}
+	name = fixUpFuncName(name, awsServiceUpper)
+	singularName := plural.Singular(name)
+	if singularName == name {
+		log.Fatalf("singular name for \"%s\" could not be determined", opName)
+	}
+
+	prefix := "find"
+	if export {
+		prefix = "Find"
+	}
+
+	return FuncSpecV2{
+		Name:          prefix + name,
+		SingularName:  prefix + singularName,
+		AWSName:       opName,
+		AWSPackage:    pkg.Name,
+		ItemsField:    items.Name(),
+		ItemType:      types.TypeString(elem, qualifier),
+		ItemIsPointer: isPointer,
+		NotFoundError: *notFoundError,
+	}
+}
+
+//go:embed header_v2.tmpl
+var headerV2Template string
+
+//go:embed function_v2.tmpl
+var functionV2Template string
@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
@@ -54,6 +55,12 @@ type resourceInterceptor interface {
 	delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
 }
 
+// A resource plan interceptor is a resource interceptor that is also invoked after the resource's ModifyPlan call.
+type resourcePlanInterceptor interface {
+	// modifyPlan is invoked for a ModifyPlan call.
+	modifyPlan(context.Context, resource.ModifyPlanRequest, *resource.ModifyPlanResponse, *conns.AWSClient, diag.Diagnostics) (context.Context, diag.Diagnostics)
+}
+
 type resourceInterceptors []resourceInterceptor
 
 type resourceInterceptorFunc[Request resourceCRUDRequest, Response resourceCRUDResponse] func(context.Context, Request, *Response, *conns.AWSClient, when, diag.Diagnostics) (context.Context, diag.Diagnostics)
@@ -116,7 +123,10 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, request, response)
+		// A Before interceptor may have handled the operation itself.
+		if !handlerSkipped(ctx) {
+			diags = f(ctx, request, response)
+		}
 
 		if diags.HasError() {
 			when = OnError
@@ -136,6 +146,23 @@ func interceptedHandler[Request resourceCRUDRequest, Response resourceCRUDRespon
 	}
 }
 
+type handlerSkippedKeyType int
+
+var handlerSkippedKey handlerSkippedKeyType
+
+// skipHandler returns a Context indicating that the resource's CRUD handler is not to be called.
+// Interceptors run After (or OnError) the handler are still invoked.
+func skipHandler(ctx context.Context) context.Context {
+	return context.WithValue(ctx, handlerSkippedKey, true)
+}
+
+// handlerSkipped returns whether a Before interceptor has indicated that the resource's CRUD handler is not to be called.
+func handlerSkipped(ctx context.Context) bool {
+	v, _ := ctx.Value(handlerSkippedKey).(bool)
+
+	return v
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, *conns.AWSClient) context.Context
 
@@ -190,6 +217,74 @@ func (r tagsDataSourceInterceptor) read(ctx context.Context, request datasource.
 	return ctx, diags
 }
 
+// wrappedEphemeralResource represents an interceptor dispatcher for a Plugin Framework ephemeral resource.
+type wrappedEphemeralResource struct {
+	// bootstrapContext is run on all wrapped methods before any interceptors.
+	bootstrapContext contextFunc
+	inner            ephemeral.EphemeralResourceWithConfigure
+	meta             *conns.AWSClient
+}
+
+func newWrappedEphemeralResource(bootstrapContext contextFunc, inner ephemeral.EphemeralResourceWithConfigure) ephemeral.EphemeralResourceWithConfigure {
+	return &wrappedEphemeralResource{
+		bootstrapContext: bootstrapContext,
+		inner:            inner,
+	}
+}
+
+func (w *wrappedEphemeralResource) Metadata(ctx context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Metadata(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Schema(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Open(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Configure(ctx context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		w.meta = v
+	}
+	ctx = w.bootstrapContext(ctx, w.meta)
+	w.inner.Configure(ctx, request, response)
+}
+
+func (w *wrappedEphemeralResource) Renew(ctx context.Context, request ephemeral.RenewRequest, response *ephemeral.RenewResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithRenew); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Renew(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithClose); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.Close(ctx, request, response)
+	}
+}
+
+func (w *wrappedEphemeralResource) ConfigValidators(ctx context.Context) []ephemeral.ConfigValidator {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithConfigValidators); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		return v.ConfigValidators(ctx)
+	}
+
+	return nil
+}
+
+func (w *wrappedEphemeralResource) ValidateConfig(ctx context.Context, request ephemeral.ValidateConfigRequest, response *ephemeral.ValidateConfigResponse) {
+	if v, ok := w.inner.(ephemeral.EphemeralResourceWithValidateConfig); ok {
+		ctx = w.bootstrapContext(ctx, w.meta)
+		v.ValidateConfig(ctx, request, response)
+	}
+}
+
 // wrappedResource represents an interceptor dispatcher for a Plugin Framework resource.
 type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
@@ -233,6 +328,7 @@ func (w *wrappedResource) Read(ctx context.Context, request resource.ReadRequest
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	resourceIDInContext(ctx, request.State.GetAttribute)
 	diags := interceptedHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -243,6 +339,7 @@ func (w *wrappedResource) Update(ctx context.Context, request resource.UpdateReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	resourceIDInContext(ctx, request.State.GetAttribute)
 	diags := interceptedHandler(w.interceptors.update(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -253,6 +350,7 @@ func (w *wrappedResource) Delete(ctx context.Context, request resource.DeleteReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
+	resourceIDInContext(ctx, request.State.GetAttribute)
 	diags := interceptedHandler(w.interceptors.delete(), f, w.meta)(ctx, request, response)
 	response.Diagnostics = diags
 }
@@ -267,7 +365,22 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
+		// Resources that define their own top-level `region` argument don't support the per-resource Region override.
+		if _, ok := w.inner.(interface{ hasRegionOverride() }); !ok {
+			if _, region, ok := conns.ParseRegionalImportID(request.ID); ok {
+				response.Diagnostics.AddError(
+					"Unsupported Region Override",
+					fmt.Sprintf("This resource does not support importing from a specific Region (%q). Remove the \"@%[1]s\" suffix from the import ID.", region),
+				)
+				return
+			}
+		}
+
 		ctx = w.bootstrapContext(ctx, w.meta)
+		// The import ID.
+		if inContext, ok := conns.FromContext(ctx); ok {
+			inContext.ResourceID = request.ID
+		}
 		v.ImportState(ctx, request, response)
 
 		return
@@ -280,11 +393,21 @@ func (w *wrappedResource) ImportState(ctx context.Context, request resource.Impo
 }
 
 func (w *wrappedResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	ctx = w.bootstrapContext(ctx, w.meta)
+
 	if v, ok := w.inner.(resource.ResourceWithModifyPlan); ok {
-		ctx = w.bootstrapContext(ctx, w.meta)
 		v.ModifyPlan(ctx, request, response)
 
-		return
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Plan interceptors are run after the resource's own plan modification.
+	for _, v := range w.interceptors {
+		if v, ok := v.(resourcePlanInterceptor); ok {
+			ctx, response.Diagnostics = v.modifyPlan(ctx, request, response, w.meta, response.Diagnostics)
+		}
 	}
 }
 
@@ -314,6 +437,109 @@ func (w *wrappedResource) UpgradeState(ctx context.Context) map[int64]resource.S
 	return nil
 }
 
+// regionResourceInterceptor sets any per-resource Region override in Context.
+type regionResourceInterceptor struct{}
+
+func (r regionResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(regionInContext(ctx, request.Plan.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(regionInContext(ctx, request.State.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(regionInContext(ctx, request.Plan.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+func (r regionResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when == Before {
+		diags.Append(regionInContext(ctx, request.State.GetAttribute)...)
+	}
+
+	return ctx, diags
+}
+
+// regionInContext sets the value of the top-level `region` attribute, if known, as the Region override in Context.
+func regionInContext(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics) diag.Diagnostics {
+	var region fwtypes.String
+	diags := getAttribute(ctx, path.Root(names.AttrRegion), &region)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := region.ValueString(); v != "" {
+		setRegionInContext(ctx, v)
+	}
+
+	return diags
+}
+
+// resourceIDInContext sets the value of the top-level `id` attribute, if any, as the resource ID in Context.
+func resourceIDInContext(ctx context.Context, getAttribute func(context.Context, path.Path, interface{}) diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return
+	}
+
+	var id fwtypes.String
+	// Not all resources have an `id` attribute.
+	if diags := getAttribute(ctx, path.Root(names.AttrID), &id); !diags.HasError() {
+		inContext.ResourceID = id.ValueString()
+	}
+}
+
+// retainOnDestroyResourceInterceptor implements the provider-level retain_on_destroy setting for resources.
+// The resource is removed from state without calling the resource's Delete handler.
+type retainOnDestroyResourceInterceptor struct{}
+
+func (r retainOnDestroyResourceInterceptor) create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r retainOnDestroyResourceInterceptor) read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r retainOnDestroyResourceInterceptor) update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	return ctx, diags
+}
+
+func (r retainOnDestroyResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if when != Before || meta == nil {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	if !meta.RetainOnDestroy(inContext.TypeName) {
+		return ctx, diags
+	}
+
+	diags.AddWarning(
+		"Resource Retained",
+		fmt.Sprintf("%s has been removed from Terraform state but has not been deleted: resource type is configured in the provider's retain_on_destroy", inContext.TypeName),
+	)
+
+	return skipHandler(ctx), diags
+}
+
 // tagsResourceInterceptor implements transparent tagging for resources.
 type tagsResourceInterceptor struct {
 	tags *types.ServicePackageResourceTags
@@ -574,6 +800,53 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 	return ctx, diags
 }
 
+func (r tagsResourceInterceptor) modifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse, meta *conns.AWSClient, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	if r.tags == nil {
+		return ctx, diags
+	}
+
+	// If the entire plan is null, the resource is planned for destruction.
+	if request.Plan.Raw.IsNull() {
+		return ctx, diags
+	}
+
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	var planTags fwtypes.Map
+	diags.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTags), &planTags)...)
+
+	if diags.HasError() {
+		return ctx, diags
+	}
+
+	// Unknown tags are only checked once known.
+	if planTags.IsUnknown() {
+		return ctx, diags
+	}
+	for _, v := range planTags.Elements() {
+		if v.IsUnknown() {
+			return ctx, diags
+		}
+	}
+
+	// Calculate tags_all as framework.ResourceWithConfigure.SetTagsAll does.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, planTags)).IgnoreConfig(tagsInContext.IgnoreConfig)
+
+	if err := tagsInContext.PolicyConfig.Validate(tags); err != nil {
+		diags.AddAttributeError(path.Root(names.AttrTags), "Tag policy violation", fmt.Sprintf("%s %s violates provider tag_policy: %s", inContext.TypeName, names.AttrTagsAll, err))
+	}
+
+	return ctx, diags
+}
+
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
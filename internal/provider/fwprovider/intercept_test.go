@@ -0,0 +1,198 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+type testEphemeralResource struct {
+	configureMeta any
+	openInContext *conns.InContext
+}
+
+func (r *testEphemeralResource) Metadata(_ context.Context, request ephemeral.MetadataRequest, response *ephemeral.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testEphemeralResource) Schema(context.Context, ephemeral.SchemaRequest, *ephemeral.SchemaResponse) {
+}
+
+func (r *testEphemeralResource) Configure(_ context.Context, request ephemeral.ConfigureRequest, response *ephemeral.ConfigureResponse) {
+	r.configureMeta = request.ProviderData
+}
+
+func (r *testEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	r.openInContext, _ = conns.FromContext(ctx)
+}
+
+type testEphemeralResourceWithClose struct {
+	testEphemeralResource
+	closeInContext *conns.InContext
+}
+
+func (r *testEphemeralResourceWithClose) Close(ctx context.Context, request ephemeral.CloseRequest, response *ephemeral.CloseResponse) {
+	r.closeInContext, _ = conns.FromContext(ctx)
+}
+
+func TestWrappedEphemeralResource(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := &conns.AWSClient{}
+	var bootstrapMeta *conns.AWSClient
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		bootstrapMeta = meta
+		return conns.NewEphemeralResourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	inner := &testEphemeralResourceWithClose{}
+	w := newWrappedEphemeralResource(bootstrapContext, inner)
+
+	w.Configure(ctx, ephemeral.ConfigureRequest{ProviderData: client}, &ephemeral.ConfigureResponse{})
+
+	if got, want := inner.configureMeta, any(client); got != want {
+		t.Errorf("inner Configure ProviderData = %v, want %v", got, want)
+	}
+	if got, want := bootstrapMeta, client; got != want {
+		t.Errorf("bootstrapContext meta = %v, want %v", got, want)
+	}
+
+	w.Open(ctx, ephemeral.OpenRequest{}, &ephemeral.OpenResponse{})
+
+	if inner.openInContext == nil {
+		t.Fatal("inner Open not called with bootstrapped Context")
+	}
+	if got, want := inner.openInContext.TypeName, "aws_test"; got != want {
+		t.Errorf("Open InContext.TypeName = %v, want %v", got, want)
+	}
+	if !inner.openInContext.IsEphemeral {
+		t.Errorf("Open InContext.IsEphemeral = false, want true")
+	}
+
+	w.(ephemeral.EphemeralResourceWithClose).Close(ctx, ephemeral.CloseRequest{}, &ephemeral.CloseResponse{})
+
+	if inner.closeInContext == nil {
+		t.Fatal("inner Close not called with bootstrapped Context")
+	}
+}
+
+func TestWrappedEphemeralResourceOptionalMethods(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	var bootstrapped bool
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		bootstrapped = true
+		return ctx
+	}
+
+	w := newWrappedEphemeralResource(bootstrapContext, &testEphemeralResource{})
+
+	// The inner ephemeral resource implements neither Renew nor Close.
+	renewResponse := ephemeral.RenewResponse{}
+	w.(ephemeral.EphemeralResourceWithRenew).Renew(ctx, ephemeral.RenewRequest{}, &renewResponse)
+	closeResponse := ephemeral.CloseResponse{}
+	w.(ephemeral.EphemeralResourceWithClose).Close(ctx, ephemeral.CloseRequest{}, &closeResponse)
+
+	if renewResponse.Diagnostics.HasError() || closeResponse.Diagnostics.HasError() {
+		t.Errorf("unexpected errors: %v, %v", renewResponse.Diagnostics, closeResponse.Diagnostics)
+	}
+	if bootstrapped {
+		t.Errorf("bootstrapContext called for unimplemented methods")
+	}
+	if got := w.(ephemeral.EphemeralResourceWithConfigValidators).ConfigValidators(ctx); got != nil {
+		t.Errorf("ConfigValidators = %v, want nil", got)
+	}
+}
+
+type testResourceWithDelete struct {
+	testResource
+	deleted bool
+}
+
+func (r *testResourceWithDelete) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+	r.deleted = true
+}
+
+func TestRetainOnDestroyResourceInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resourceTypes []string
+		wantDeleted   bool
+	}{
+		"not retained": {
+			resourceTypes: []string{"aws_other"},
+			wantDeleted:   true,
+		},
+		"retained": {
+			resourceTypes: []string{"aws_other", "aws_test"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+				return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+			}
+
+			inner := &testResourceWithDelete{}
+			w := newWrappedResource(bootstrapContext, inner, resourceInterceptors{retainOnDestroyResourceInterceptor{}})
+			w.Configure(ctx, resource.ConfigureRequest{ProviderData: &conns.AWSClient{RetainOnDestroyResourceTypes: testCase.resourceTypes}}, &resource.ConfigureResponse{})
+
+			s := schema.Schema{
+				Attributes: map[string]schema.Attribute{
+					names.AttrID: schema.StringAttribute{
+						Computed: true,
+					},
+				},
+			}
+			tfType := s.Type().TerraformType(ctx)
+			request := resource.DeleteRequest{
+				State: tfsdk.State{
+					Schema: s,
+					Raw: tftypes.NewValue(tfType, map[string]tftypes.Value{
+						names.AttrID: tftypes.NewValue(tftypes.String, "id-1"),
+					}),
+				},
+			}
+			// The framework removes the resource from state unless Delete sets it.
+			response := resource.DeleteResponse{
+				State: tfsdk.State{
+					Schema: s,
+					Raw:    tftypes.NewValue(tfType, nil),
+				},
+			}
+
+			w.Delete(ctx, request, &response)
+
+			if response.Diagnostics.HasError() {
+				t.Fatalf("unexpected errors: %v", response.Diagnostics)
+			}
+			if !response.State.Raw.IsNull() {
+				t.Errorf("state = %v, want null", response.State.Raw)
+			}
+			if got, want := inner.deleted, testCase.wantDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+			if got, want := response.Diagnostics.WarningsCount() == 1, !testCase.wantDeleted; got != want {
+				t.Errorf("warning = %t, want %t: %v", got, want, response.Diagnostics)
+			}
+		})
+	}
+}
@@ -0,0 +1,688 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	regionNameRegexp = regexache.MustCompile(`^[a-z]{2}(-[a-z]+)+-\d$`)
+)
+
+// regionDataSource injects a top-level `region` attribute into a data source that does not define one.
+// The attribute is removed from the configuration and state seen by the inner data source.
+type regionDataSource struct {
+	inner       datasource.DataSourceWithConfigure
+	innerSchema datasourceschema.Schema
+	meta        *conns.AWSClient
+}
+
+func newRegionDataSource(inner datasource.DataSourceWithConfigure, innerSchema datasourceschema.Schema) datasource.DataSourceWithConfigure {
+	return &regionDataSource{
+		inner:       inner,
+		innerSchema: innerSchema,
+	}
+}
+
+func (r *regionDataSource) Metadata(ctx context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	r.inner.Schema(ctx, request, response)
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]datasourceschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = datasourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region to use for this data source. Defaults to the Region set in the provider configuration.",
+		Validators:  regionValidators(),
+	}
+}
+
+func (r *regionDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	t := newRegionTransform(ctx, r.innerSchema.Type().TerraformType(ctx), response.State.Schema.Type().TerraformType(ctx))
+
+	config, region, err := t.strip(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from configuration", err.Error())
+		return
+	}
+	region = r.resolve(ctx, region)
+
+	outerSchema := response.State.Schema
+	request.Config = tfsdk.Config{Raw: config, Schema: r.innerSchema}
+	response.State = tfsdk.State{Raw: t.null(), Schema: r.innerSchema}
+
+	r.inner.Read(ctx, request, response)
+
+	state, err := t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+// resolve sets any configured Region in Context and returns the effective Region.
+func (r *regionDataSource) resolve(ctx context.Context, region tftypes.Value) tftypes.Value {
+	if v := regionFromValue(region); v != "" {
+		setRegionInContext(ctx, v)
+		return region
+	}
+
+	if r.meta == nil {
+		return region
+	}
+
+	return tftypes.NewValue(tftypes.String, r.meta.Region(ctx))
+}
+
+// regionResource injects a top-level `region` attribute into a resource that does not define one.
+// The attribute is removed from the configuration, plan and state seen by the inner resource.
+// The Region override is set in Context by regionResourceInterceptor for CRUD operations.
+type regionResource struct {
+	inner       resource.ResourceWithConfigure
+	innerSchema resourceschema.Schema
+	meta        *conns.AWSClient
+}
+
+// newRegionResource returns the wrapped resource.
+// Optional resource interfaces are implemented only if the inner resource implements them.
+// ModifyPlan is always implemented as it plans the resource's Region.
+func newRegionResource(inner resource.ResourceWithConfigure, innerSchema resourceschema.Schema) resource.ResourceWithConfigure {
+	r := &regionResource{
+		inner:       inner,
+		innerSchema: innerSchema,
+	}
+
+	var mask int
+	if _, ok := inner.(resource.ResourceWithImportState); ok {
+		mask |= regionResourceImportState
+	}
+	if _, ok := inner.(resource.ResourceWithUpgradeState); ok {
+		mask |= regionResourceUpgradeState
+	}
+	if _, ok := inner.(resource.ResourceWithValidateConfig); ok {
+		mask |= regionResourceValidateConfig
+	}
+	if _, ok := inner.(resource.ResourceWithConfigValidators); ok {
+		mask |= regionResourceConfigValidators
+	}
+
+	i, u, v, c := regionImportState{r}, regionUpgradeState{r}, regionValidateConfig{r}, regionConfigValidators{r}
+
+	switch mask {
+	case 0:
+		return r
+	case regionResourceImportState:
+		return &struct {
+			*regionResource
+			regionImportState
+		}{r, i}
+	case regionResourceUpgradeState:
+		return &struct {
+			*regionResource
+			regionUpgradeState
+		}{r, u}
+	case regionResourceImportState | regionResourceUpgradeState:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionUpgradeState
+		}{r, i, u}
+	case regionResourceValidateConfig:
+		return &struct {
+			*regionResource
+			regionValidateConfig
+		}{r, v}
+	case regionResourceImportState | regionResourceValidateConfig:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionValidateConfig
+		}{r, i, v}
+	case regionResourceUpgradeState | regionResourceValidateConfig:
+		return &struct {
+			*regionResource
+			regionUpgradeState
+			regionValidateConfig
+		}{r, u, v}
+	case regionResourceImportState | regionResourceUpgradeState | regionResourceValidateConfig:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionUpgradeState
+			regionValidateConfig
+		}{r, i, u, v}
+	case regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionConfigValidators
+		}{r, c}
+	case regionResourceImportState | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionConfigValidators
+		}{r, i, c}
+	case regionResourceUpgradeState | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionUpgradeState
+			regionConfigValidators
+		}{r, u, c}
+	case regionResourceImportState | regionResourceUpgradeState | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionUpgradeState
+			regionConfigValidators
+		}{r, i, u, c}
+	case regionResourceValidateConfig | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionValidateConfig
+			regionConfigValidators
+		}{r, v, c}
+	case regionResourceImportState | regionResourceValidateConfig | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionValidateConfig
+			regionConfigValidators
+		}{r, i, v, c}
+	case regionResourceUpgradeState | regionResourceValidateConfig | regionResourceConfigValidators:
+		return &struct {
+			*regionResource
+			regionUpgradeState
+			regionValidateConfig
+			regionConfigValidators
+		}{r, u, v, c}
+	default:
+		return &struct {
+			*regionResource
+			regionImportState
+			regionUpgradeState
+			regionValidateConfig
+			regionConfigValidators
+		}{r, i, u, v, c}
+	}
+}
+
+// Optional resource interfaces implemented by the inner resource.
+const (
+	regionResourceImportState = 1 << iota
+	regionResourceUpgradeState
+	regionResourceValidateConfig
+	regionResourceConfigValidators
+)
+
+// hasRegionOverride marks a resource as supporting the per-resource Region override.
+func (r *regionResource) hasRegionOverride() {}
+
+func (r *regionResource) Metadata(ctx context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	r.inner.Metadata(ctx, request, response)
+}
+
+func (r *regionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	r.inner.Schema(ctx, request, response)
+
+	if response.Schema.Attributes == nil {
+		response.Schema.Attributes = make(map[string]resourceschema.Attribute)
+	}
+	response.Schema.Attributes[names.AttrRegion] = resourceRegionAttribute()
+}
+
+func (r *regionResource) Configure(ctx context.Context, request resource.ConfigureRequest, response *resource.ConfigureResponse) {
+	if v, ok := request.ProviderData.(*conns.AWSClient); ok {
+		r.meta = v
+	}
+	r.inner.Configure(ctx, request, response)
+}
+
+func (r *regionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	t := r.transform(ctx, response.State.Schema)
+
+	config, _, err := t.strip(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from configuration", err.Error())
+		return
+	}
+	plan, region, err := t.strip(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from plan", err.Error())
+		return
+	}
+	region = r.defaultRegion(ctx, region)
+
+	outerSchema := response.State.Schema
+	request.Config = tfsdk.Config{Raw: config, Schema: r.innerSchema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: r.innerSchema}
+	response.State = tfsdk.State{Raw: t.null(), Schema: r.innerSchema}
+
+	r.inner.Create(ctx, request, response)
+
+	state, err := t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+func (r *regionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	t := r.transform(ctx, response.State.Schema)
+
+	state, region, err := t.strip(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from state", err.Error())
+		return
+	}
+	region = r.defaultRegion(ctx, region)
+
+	outerSchema := response.State.Schema
+	request.State = tfsdk.State{Raw: state, Schema: r.innerSchema}
+	response.State = tfsdk.State{Raw: state.Copy(), Schema: r.innerSchema}
+
+	r.inner.Read(ctx, request, response)
+
+	state, err = t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+func (r *regionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	t := r.transform(ctx, response.State.Schema)
+
+	config, _, err := t.strip(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from configuration", err.Error())
+		return
+	}
+	plan, region, err := t.strip(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from plan", err.Error())
+		return
+	}
+	state, _, err := t.strip(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from state", err.Error())
+		return
+	}
+	region = r.defaultRegion(ctx, region)
+
+	outerSchema := response.State.Schema
+	request.Config = tfsdk.Config{Raw: config, Schema: r.innerSchema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: r.innerSchema}
+	request.State = tfsdk.State{Raw: state, Schema: r.innerSchema}
+	response.State = tfsdk.State{Raw: plan.Copy(), Schema: r.innerSchema}
+
+	r.inner.Update(ctx, request, response)
+
+	state, err = t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+func (r *regionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	t := r.transform(ctx, response.State.Schema)
+
+	state, region, err := t.strip(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from state", err.Error())
+		return
+	}
+
+	outerSchema := response.State.Schema
+	request.State = tfsdk.State{Raw: state, Schema: r.innerSchema}
+	response.State = tfsdk.State{Raw: state.Copy(), Schema: r.innerSchema}
+
+	r.inner.Delete(ctx, request, response)
+
+	state, err = t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+// regionImportState implements resource.ResourceWithImportState for a region-wrapped resource.
+type regionImportState struct {
+	r *regionResource
+}
+
+// ImportState supports an optional "@<region>" suffix on the import ID.
+func (w regionImportState) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r := w.r
+	v := r.inner.(resource.ResourceWithImportState)
+
+	t := r.transform(ctx, response.State.Schema)
+
+	region := tftypes.NewValue(tftypes.String, nil)
+	if id, v, ok := conns.ParseRegionalImportID(request.ID); ok {
+		request.ID = id
+		region = tftypes.NewValue(tftypes.String, v)
+		setRegionInContext(ctx, v)
+	}
+
+	outerSchema := response.State.Schema
+	response.State = tfsdk.State{Raw: t.null(), Schema: r.innerSchema}
+
+	v.ImportState(ctx, request, response)
+
+	state, err := t.add(response.State.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to state", err.Error())
+		return
+	}
+	response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+}
+
+// ModifyPlan defaults the planned Region of a new resource to the provider's Region.
+func (r *regionResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Nothing to do on destroy.
+	if request.Plan.Raw.IsNull() {
+		if v, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+			r.modifyPlan(ctx, v, request, response)
+		}
+		return
+	}
+
+	var region fwtypes.String
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrRegion), &region)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if region.IsUnknown() && request.State.Raw.IsNull() && r.meta != nil {
+		region = fwtypes.StringValue(r.meta.Region(ctx))
+		response.Diagnostics.Append(response.Plan.SetAttribute(ctx, path.Root(names.AttrRegion), region)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if v := region.ValueString(); v != "" {
+		setRegionInContext(ctx, v)
+	}
+
+	if v, ok := r.inner.(resource.ResourceWithModifyPlan); ok {
+		request.Plan = tfsdk.Plan{Raw: response.Plan.Raw, Schema: response.Plan.Schema}
+		r.modifyPlan(ctx, v, request, response)
+	}
+}
+
+func (r *regionResource) modifyPlan(ctx context.Context, inner resource.ResourceWithModifyPlan, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	t := r.transform(ctx, response.Plan.Schema)
+
+	config, _, err := t.strip(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from configuration", err.Error())
+		return
+	}
+	plan, region, err := t.strip(request.Plan.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from plan", err.Error())
+		return
+	}
+	state, _, err := t.strip(request.State.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from state", err.Error())
+		return
+	}
+
+	outerSchema := response.Plan.Schema
+	request.Config = tfsdk.Config{Raw: config, Schema: r.innerSchema}
+	request.Plan = tfsdk.Plan{Raw: plan, Schema: r.innerSchema}
+	request.State = tfsdk.State{Raw: state, Schema: r.innerSchema}
+	response.Plan = tfsdk.Plan{Raw: plan.Copy(), Schema: r.innerSchema}
+
+	inner.ModifyPlan(ctx, request, response)
+
+	plan, err = t.add(response.Plan.Raw, region)
+	if err != nil {
+		response.Diagnostics.AddError("Adding region to plan", err.Error())
+		return
+	}
+	response.Plan = tfsdk.Plan{Raw: plan, Schema: outerSchema}
+}
+
+// regionConfigValidators implements resource.ResourceWithConfigValidators for a region-wrapped resource.
+type regionConfigValidators struct {
+	r *regionResource
+}
+
+func (w regionConfigValidators) ConfigValidators(ctx context.Context) []resource.ConfigValidator {
+	return w.r.inner.(resource.ResourceWithConfigValidators).ConfigValidators(ctx)
+}
+
+// regionValidateConfig implements resource.ResourceWithValidateConfig for a region-wrapped resource.
+type regionValidateConfig struct {
+	r *regionResource
+}
+
+func (w regionValidateConfig) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	r := w.r
+	v := r.inner.(resource.ResourceWithValidateConfig)
+
+	t := r.transform(ctx, request.Config.Schema)
+
+	config, _, err := t.strip(request.Config.Raw)
+	if err != nil {
+		response.Diagnostics.AddError("Removing region from configuration", err.Error())
+		return
+	}
+	request.Config = tfsdk.Config{Raw: config, Schema: r.innerSchema}
+
+	v.ValidateConfig(ctx, request, response)
+}
+
+// regionUpgradeState implements resource.ResourceWithUpgradeState for a region-wrapped resource.
+type regionUpgradeState struct {
+	r *regionResource
+}
+
+// UpgradeState wraps the inner resource's state upgraders so that any Region in the prior state is preserved.
+func (w regionUpgradeState) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	r := w.r
+	v := r.inner.(resource.ResourceWithUpgradeState)
+
+	stateUpgraders := v.UpgradeState(ctx)
+	for version, stateUpgrader := range stateUpgraders {
+		upgrade := stateUpgrader.StateUpgrader
+		stateUpgrader.StateUpgrader = func(ctx context.Context, request resource.UpgradeStateRequest, response *resource.UpgradeStateResponse) {
+			t := r.transform(ctx, response.State.Schema)
+
+			region := tftypes.NewValue(tftypes.String, nil)
+			if request.RawState != nil && len(request.RawState.JSON) > 0 {
+				var raw struct {
+					Region *string `json:"region"`
+				}
+				if err := json.Unmarshal(request.RawState.JSON, &raw); err == nil && raw.Region != nil {
+					region = tftypes.NewValue(tftypes.String, *raw.Region)
+				}
+			}
+
+			outerSchema := response.State.Schema
+			response.State = tfsdk.State{Schema: r.innerSchema}
+
+			upgrade(ctx, request, response)
+
+			if response.State.Raw.Type() == nil {
+				response.State = tfsdk.State{Schema: outerSchema}
+				return
+			}
+
+			state, err := t.add(response.State.Raw, region)
+			if err != nil {
+				response.Diagnostics.AddError("Adding region to upgraded state", err.Error())
+				return
+			}
+			response.State = tfsdk.State{Raw: state, Schema: outerSchema}
+		}
+		stateUpgraders[version] = stateUpgrader
+	}
+
+	return stateUpgraders
+}
+
+func (r *regionResource) transform(ctx context.Context, outerSchema interface {
+	Type() attr.Type
+}) regionTransform {
+	return newRegionTransform(ctx, r.innerSchema.Type().TerraformType(ctx), outerSchema.Type().TerraformType(ctx))
+}
+
+// defaultRegion returns the provider's Region if the specified Region value is not set.
+func (r *regionResource) defaultRegion(ctx context.Context, region tftypes.Value) tftypes.Value {
+	if regionFromValue(region) != "" || r.meta == nil {
+		return region
+	}
+
+	return tftypes.NewValue(tftypes.String, r.meta.Region(ctx))
+}
+
+func resourceRegionAttribute() resourceschema.StringAttribute {
+	return resourceschema.StringAttribute{
+		Optional:    true,
+		Computed:    true,
+		Description: "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration.",
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+			stringplanmodifier.RequiresReplace(),
+		},
+		Validators: regionValidators(),
+	}
+}
+
+func regionValidators() []validator.String {
+	return []validator.String{
+		stringvalidator.RegexMatches(regionNameRegexp, "must be a valid AWS Region name"),
+	}
+}
+
+// regionTransform converts object values between a schema with an injected `region` attribute and the inner schema.
+type regionTransform struct {
+	innerType tftypes.Type
+	outerType tftypes.Type
+}
+
+func newRegionTransform(_ context.Context, innerType, outerType tftypes.Type) regionTransform {
+	return regionTransform{
+		innerType: innerType,
+		outerType: outerType,
+	}
+}
+
+// null returns a null value of the inner type.
+func (t regionTransform) null() tftypes.Value {
+	return tftypes.NewValue(t.innerType, nil)
+}
+
+// strip returns the value with the `region` attribute removed, along with the `region` attribute's value.
+func (t regionTransform) strip(v tftypes.Value) (tftypes.Value, tftypes.Value, error) {
+	region := tftypes.NewValue(tftypes.String, nil)
+
+	if v.Type() == nil || v.IsNull() {
+		return tftypes.NewValue(t.innerType, nil), region, nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(t.innerType, tftypes.UnknownValue), tftypes.NewValue(tftypes.String, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, region, err
+	}
+
+	if v, ok := attrs[names.AttrRegion]; ok {
+		region = v
+		delete(attrs, names.AttrRegion)
+	}
+
+	if err := tftypes.ValidateValue(t.innerType, attrs); err != nil {
+		return tftypes.Value{}, region, err
+	}
+
+	return tftypes.NewValue(t.innerType, attrs), region, nil
+}
+
+// add returns the value with the `region` attribute added.
+func (t regionTransform) add(v tftypes.Value, region tftypes.Value) (tftypes.Value, error) {
+	if v.Type() == nil || v.IsNull() {
+		return tftypes.NewValue(t.outerType, nil), nil
+	}
+	if !v.IsKnown() {
+		return tftypes.NewValue(t.outerType, tftypes.UnknownValue), nil
+	}
+
+	var attrs map[string]tftypes.Value
+	if err := v.As(&attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	attrs[names.AttrRegion] = region
+
+	if err := tftypes.ValidateValue(t.outerType, attrs); err != nil {
+		return tftypes.Value{}, err
+	}
+
+	return tftypes.NewValue(t.outerType, attrs), nil
+}
+
+// regionFromValue returns the known, non-null string value of a `region` attribute.
+func regionFromValue(v tftypes.Value) string {
+	if v.Type() == nil || !v.IsKnown() || v.IsNull() {
+		return ""
+	}
+
+	var region string
+	if err := v.As(&region); err != nil {
+		return ""
+	}
+
+	return region
+}
+
+// setRegionInContext sets the per-resource Region override in Context.
+func setRegionInContext(ctx context.Context, region string) {
+	if v, ok := conns.FromContext(ctx); ok {
+		v.Region = region
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+
+type testResource struct{}
+
+func (r *testResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_test"
+}
+
+func (r *testResource) Schema(context.Context, resource.SchemaRequest, *resource.SchemaResponse) {
+}
+
+func (r *testResource) Configure(context.Context, resource.ConfigureRequest, *resource.ConfigureResponse) {
+}
+
+func (r *testResource) Create(context.Context, resource.CreateRequest, *resource.CreateResponse) {
+}
+
+func (r *testResource) Read(context.Context, resource.ReadRequest, *resource.ReadResponse) {
+}
+
+func (r *testResource) Update(context.Context, resource.UpdateRequest, *resource.UpdateResponse) {
+}
+
+func (r *testResource) Delete(context.Context, resource.DeleteRequest, *resource.DeleteResponse) {
+}
+
+type testResourceWithImportState struct {
+	testResource
+	importID string
+}
+
+func (r *testResourceWithImportState) ImportState(_ context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	r.importID = request.ID
+}
+
+type testResourceWithUpgradeStateAndConfigValidators struct {
+	testResource
+}
+
+func (r *testResourceWithUpgradeStateAndConfigValidators) UpgradeState(context.Context) map[int64]resource.StateUpgrader {
+	return map[int64]resource.StateUpgrader{}
+}
+
+func (r *testResourceWithUpgradeStateAndConfigValidators) ConfigValidators(context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{}
+}
+
+func TestRegionResourceOptionalInterfaces(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		inner                resource.ResourceWithConfigure
+		wantImportState      bool
+		wantUpgradeState     bool
+		wantValidateConfig   bool
+		wantConfigValidators bool
+	}{
+		"none": {
+			inner: &testResource{},
+		},
+		"ImportState": {
+			inner:           &testResourceWithImportState{},
+			wantImportState: true,
+		},
+		"UpgradeState and ConfigValidators": {
+			inner:                &testResourceWithUpgradeStateAndConfigValidators{},
+			wantUpgradeState:     true,
+			wantConfigValidators: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := newRegionResource(testCase.inner, schema.Schema{})
+
+			if _, ok := r.(resource.ResourceWithModifyPlan); !ok {
+				t.Error("ModifyPlan not implemented")
+			}
+			if _, ok := r.(resource.ResourceWithImportState); ok != testCase.wantImportState {
+				t.Errorf("ImportState implemented = %t, want %t", ok, testCase.wantImportState)
+			}
+			if _, ok := r.(resource.ResourceWithUpgradeState); ok != testCase.wantUpgradeState {
+				t.Errorf("UpgradeState implemented = %t, want %t", ok, testCase.wantUpgradeState)
+			}
+			if _, ok := r.(resource.ResourceWithValidateConfig); ok != testCase.wantValidateConfig {
+				t.Errorf("ValidateConfig implemented = %t, want %t", ok, testCase.wantValidateConfig)
+			}
+			if _, ok := r.(resource.ResourceWithConfigValidators); ok != testCase.wantConfigValidators {
+				t.Errorf("ConfigValidators implemented = %t, want %t", ok, testCase.wantConfigValidators)
+			}
+		})
+	}
+}
+
+func TestWrappedResourceImportStateRegionOverride(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bootstrapContext := func(ctx context.Context, meta *conns.AWSClient) context.Context {
+		return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+	}
+
+	// The inner resource defines its own top-level `region` attribute so isn't region-wrapped.
+	inner := &testResourceWithImportState{}
+	w := newWrappedResource(bootstrapContext, inner, resourceInterceptors{})
+
+	response := resource.ImportStateResponse{}
+	w.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "test-id@eu-west-1"}, &response) //lintignore:AWSAT003
+
+	if !response.Diagnostics.HasError() {
+		t.Error("expected error importing with Region override")
+	}
+	if inner.importID != "" {
+		t.Errorf("inner ImportState called with %q", inner.importID)
+	}
+
+	response = resource.ImportStateResponse{}
+	w.(resource.ResourceWithImportState).ImportState(ctx, resource.ImportStateRequest{ID: "test-id"}, &response)
+
+	if response.Diagnostics.HasError() {
+		t.Errorf("unexpected error: %v", response.Diagnostics)
+	}
+	if got, want := inner.importID, "test-id"; got != want {
+		t.Errorf("inner ImportState ID = %q, want %q", got, want)
+	}
+}
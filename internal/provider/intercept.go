@@ -91,6 +91,9 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
 		var diags diag.Diagnostics
 		ctx = bootstrapContext(ctx, meta)
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = d.Id()
+		}
 		// Before interceptors are run first to last.
 		forward := interceptors.why(why)
 
@@ -108,7 +111,10 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 
 		// All other interceptors are run last to first.
 		reverse := slices.Reverse(forward)
-		diags = f(ctx, d, meta)
+		// A Before interceptor may have handled the operation itself.
+		if !handlerSkipped(ctx) {
+			diags = f(ctx, d, meta)
+		}
 
 		if diags.HasError() {
 			when = OnError
@@ -132,6 +138,23 @@ func interceptedHandler[F ~func(context.Context, *schema.ResourceData, any) diag
 	}
 }
 
+type handlerSkippedKeyType int
+
+var handlerSkippedKey handlerSkippedKeyType
+
+// skipHandler returns a Context indicating that the schema's CRUD handler is not to be called.
+// Interceptors run After (or OnError) the handler are still invoked.
+func skipHandler(ctx context.Context) context.Context {
+	return context.WithValue(ctx, handlerSkippedKey, true)
+}
+
+// handlerSkipped returns whether a Before interceptor has indicated that the schema's CRUD handler is not to be called.
+func handlerSkipped(ctx context.Context) bool {
+	v, _ := ctx.Value(handlerSkippedKey).(bool)
+
+	return v
+}
+
 // contextFunc augments Context.
 type contextFunc func(context.Context, any) context.Context
 
@@ -172,6 +195,10 @@ func (r *wrappedResource) Delete(f schema.DeleteContextFunc) schema.DeleteContex
 func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFunc {
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
+		// The import ID.
+		if v, ok := conns.FromContext(ctx); ok {
+			v.ResourceID = d.Id()
+		}
 
 		return f(ctx, d, meta)
 	}
@@ -193,6 +220,67 @@ func (r *wrappedResource) StateUpgrade(f schema.StateUpgradeFunc) schema.StateUp
 	}
 }
 
+// regionInterceptor implements per-resource Region override for resources and data sources.
+type regionInterceptor struct{}
+
+func (r regionInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		// Use any Region configured for, or previously set in state by, the resource or data source.
+		if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+			inContext.Region = v
+		}
+	case After:
+		switch why {
+		case Read:
+			// Will occur on a refresh when the resource does not exist in AWS and needs to be recreated, e.g. "_disappears" tests.
+			if d.Id() == "" {
+				return ctx, diags
+			}
+
+			fallthrough
+		case Create, Update:
+			if err := d.Set(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx)); err != nil {
+				return ctx, sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrRegion, err)
+			}
+		}
+	}
+
+	return ctx, diags
+}
+
+// retainOnDestroyInterceptor implements the provider-level retain_on_destroy setting for resources.
+// The resource is removed from state without calling the resource's Delete handler.
+type retainOnDestroyInterceptor struct{}
+
+func (r retainOnDestroyInterceptor) run(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return ctx, diags
+	}
+
+	switch when {
+	case Before:
+		switch why {
+		case Delete:
+			if !meta.(*conns.AWSClient).RetainOnDestroy(inContext.TypeName) {
+				return ctx, diags
+			}
+
+			diags = sdkdiag.AppendWarningf(diags, "%s (%s) has been removed from Terraform state but has not been deleted: resource type is configured in the provider's retain_on_destroy", inContext.TypeName, d.Id())
+
+			return skipHandler(ctx), diags
+		}
+	}
+
+	return ctx, diags
+}
+
 type tagsCRUDFunc func(context.Context, schemaResourceData, conns.ServicePackage, *types.ServicePackageResourceTags, string, string, any, diag.Diagnostics) (context.Context, diag.Diagnostics)
 
 // tagsResourceInterceptor implements transparent tagging for resources.
@@ -9,6 +9,8 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 )
 
@@ -93,3 +95,114 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestInterceptedHandlerSkipped(t *testing.T) {
+	t.Parallel()
+
+	var interceptors interceptorItems
+	var afterCalled bool
+
+	interceptors = append(interceptors, interceptorItem{
+		when: Before,
+		why:  Delete,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			return skipHandler(ctx), sdkdiag.AppendWarningf(diags, "skipped")
+		}),
+	})
+	interceptors = append(interceptors, interceptorItem{
+		when: After,
+		why:  Delete,
+		interceptor: interceptorFunc(func(ctx context.Context, d schemaResourceData, meta any, when when, why why, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
+			afterCalled = true
+			return ctx, diags
+		}),
+	})
+
+	var deleteFunc schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+		var diags diag.Diagnostics
+		return sdkdiag.AppendErrorf(diags, "delete error")
+	}
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+
+	diags := interceptedHandler(bootstrapContext, interceptors, deleteFunc, Delete)(context.Background(), nil, 42)
+	if got, want := len(diags), 1; got != want {
+		t.Fatalf("length of diags = %v, want %v", got, want)
+	}
+	if got, want := diags[0].Severity, diag.Warning; got != want {
+		t.Errorf("diags[0].Severity = %v, want %v", got, want)
+	}
+	if !afterCalled {
+		t.Errorf("After interceptor not called")
+	}
+}
+
+func TestRetainOnDestroyInterceptor(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		resourceTypes []string
+		wantDeleted   bool
+	}{
+		"not retained": {
+			resourceTypes: []string{"aws_other"},
+			wantDeleted:   true,
+		},
+		"retained": {
+			resourceTypes: []string{"aws_other", "aws_test"},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+			var deleted bool
+
+			interceptors := interceptorItems{
+				{
+					when:        Before,
+					why:         Delete,
+					interceptor: retainOnDestroyInterceptor{},
+				},
+			}
+			var deleteFunc schema.DeleteContextFunc = func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+				deleted = true
+				return nil
+			}
+			bootstrapContext := func(ctx context.Context, meta any) context.Context {
+				return conns.NewResourceContext(ctx, "test", "Test", "aws_test")
+			}
+			r := &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"name": {
+						Type:     schema.TypeString,
+						Optional: true,
+					},
+				},
+				DeleteWithoutTimeout: interceptedHandler(bootstrapContext, interceptors, deleteFunc, Delete),
+			}
+			meta := &conns.AWSClient{
+				RetainOnDestroyResourceTypes: testCase.resourceTypes,
+			}
+
+			state, diags := r.Apply(ctx, &terraform.InstanceState{ID: "id-1"}, &terraform.InstanceDiff{Destroy: true}, meta)
+
+			if diags.HasError() {
+				t.Fatalf("unexpected errors: %v", diags)
+			}
+			if state != nil {
+				t.Errorf("state = %v, want nil", state)
+			}
+			if got, want := deleted, testCase.wantDeleted; got != want {
+				t.Errorf("deleted = %t, want %t", got, want)
+			}
+			if got, want := len(diags) == 1 && diags[0].Severity == diag.Warning, !testCase.wantDeleted; got != want {
+				t.Errorf("warning = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
@@ -9,6 +9,8 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"regexp"
+	"slices"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -16,8 +18,10 @@ import (
 	"github.com/aws/aws-sdk-go-v2/feature/ec2/imds"
 	"github.com/aws/aws-sdk-go/aws/endpoints"
 	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -48,6 +52,68 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Optional:      true,
 				ConflictsWith: []string{"forbidden_account_ids"},
 			},
+			"api_audit_log": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block for an audit log of the AWS API calls made by the provider.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"format": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      conns.APIAuditLogFormatJSONL,
+							ValidateFunc: validation.StringInSlice(conns.APIAuditLogFormat_Values(), false),
+							Description:  "Format of the audit log. Valid values are `jsonl`.",
+						},
+						"path": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "File to which an entry is appended for each AWS API call.",
+						},
+					},
+				},
+			},
+			"api_rate_limit": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings for client-side rate limiting of AWS API calls, shared per service and region.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"burst": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(1),
+							Description:  "Maximum number of AWS API calls that can be made at once per service and region.",
+						},
+						"circuit_breaker_cooldown": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ValidateFunc: verify.ValidDuration,
+							Description:  "How long AWS API calls to a service are held once the circuit breaker trips. Defaults to `30s`.",
+						},
+						"circuit_breaker_threshold": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+							Description:  "Number of consecutive throttling errors from a service that trip the circuit breaker.",
+						},
+						"requests_per_second": {
+							Type:         schema.TypeFloat,
+							Optional:     true,
+							ValidateFunc: validation.FloatAtLeast(0),
+							Description:  "Maximum rate of AWS API calls per service and region.",
+						},
+						"service_requests_per_second": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeFloat},
+							Description: "Maximum rate of AWS API calls for individual services, keyed by service name.",
+						},
+					},
+				},
+			},
 			"assume_role":                   assumeRoleSchema(),
 			"assume_role_with_web_identity": assumeRoleWithWebIdentitySchema(),
 			"custom_ca_bundle": {
@@ -64,6 +130,11 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Configuration block with settings to default resource tags across all resources.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"normalize_keys": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Description: "Treat resource and default tag keys that differ only in case or whitespace as the same key.",
+						},
 						"tags": {
 							Type:        schema.TypeMap,
 							Optional:    true,
@@ -157,6 +228,22 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS operations will take place. Examples\n" +
 					"are us-east-1, us-west-2, etc.", // lintignore:AWSAT003,
 			},
+			"retain_on_destroy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to retain resources on destroy. Resources of the specified types are removed from Terraform state but are not deleted.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_types": {
+							Type:        schema.TypeSet,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource types, e.g. `aws_s3_bucket`, that are retained on destroy.",
+						},
+					},
+				},
+			},
 			"retry_mode": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -227,6 +314,35 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "The region where AWS STS operations will take place. Examples\n" +
 					"are us-east-1 and us-west-2.", // lintignore:AWSAT003,
 			},
+			"tag_policy": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with rules that the tags of every taggable resource must satisfy. A resource whose tags violate the rules fails to plan.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_values": {
+							Type:             schema.TypeMap,
+							Optional:         true,
+							Elem:             &schema.Schema{Type: schema.TypeString},
+							Description:      "Map of tag key to a regular expression that the tag's value must match.",
+							ValidateDiagFunc: validTagPolicyAllowedValues,
+						},
+						"forbidden_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must not be present.",
+						},
+						"required_keys": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Resource tag keys that must be present.",
+						},
+					},
+				},
+			},
 			"token": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -253,7 +369,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	}
 
 	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
-		return configure(ctx, provider, d)
+		return configure(ctx, provider, d, "")
 	}
 
 	var errs []error
@@ -282,9 +398,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewDataSourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.TagPolicyConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -292,6 +408,15 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Data sources that don't define their own top-level `region` argument support a per-data source Region override.
+			if injectRegionAttribute(r, true) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         Read,
+					interceptor: regionInterceptor{},
+				})
+			}
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -359,9 +484,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 
 			// bootstrapContext is run on all wrapped methods before any interceptors.
 			bootstrapContext := func(ctx context.Context, meta any) context.Context {
-				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name)
+				ctx = conns.NewResourceContext(ctx, servicePackageName, v.Name, typeName)
 				if v, ok := meta.(*conns.AWSClient); ok {
-					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig)
+					ctx = tftags.NewContext(ctx, v.DefaultTagsConfig, v.IgnoreTagsConfig, v.TagPolicyConfig)
 					ctx = v.RegisterLogger(ctx)
 				}
 
@@ -369,6 +494,38 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			}
 			interceptors := interceptorItems{}
 
+			// Resources that don't define their own top-level `region` argument support a per-resource Region override.
+			// The Region interceptor must run before any others.
+			if injectRegionAttribute(r, false) {
+				interceptors = append(interceptors, interceptorItem{
+					when:        Before | After,
+					why:         AllOps,
+					interceptor: regionInterceptor{},
+				})
+
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(regionCustomizeDiff, v)
+				} else {
+					r.CustomizeDiff = regionCustomizeDiff
+				}
+				if v := r.Importer; v != nil {
+					if v := v.StateContext; v != nil {
+						r.Importer.StateContext = regionImportStateFunc(v)
+					}
+				}
+			} else if v := r.Importer; v != nil {
+				if v := v.StateContext; v != nil {
+					r.Importer.StateContext = noRegionImportStateFunc(v)
+				}
+			}
+
+			// Any provider configured retain_on_destroy setting is enforced on Delete.
+			interceptors = append(interceptors, interceptorItem{
+				when:        Before,
+				why:         Delete,
+				interceptor: retainOnDestroyInterceptor{},
+			})
+
 			if v.Tags != nil {
 				schema := r.SchemaMap()
 
@@ -393,6 +550,13 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					continue
 				}
 
+				// Any provider configured tag policy is enforced at plan time.
+				if v := r.CustomizeDiff; v != nil {
+					r.CustomizeDiff = customdiff.Sequence(v, tagsPolicyCustomizeDiff)
+				} else {
+					r.CustomizeDiff = tagsPolicyCustomizeDiff
+				}
+
 				interceptors = append(interceptors, interceptorItem{
 					when: Before | After | Finally,
 					why:  Create | Read | Update,
@@ -458,8 +622,16 @@ func New(ctx context.Context) (*schema.Provider, error) {
 	return provider, nil
 }
 
+// SetLocalEndpoint targets every AWS service of the specified provider at a local AWS endpoint emulator.
+// It is intended for acceptance testing only and must be called before the provider is configured.
+func SetLocalEndpoint(provider *schema.Provider, localEndpoint string) {
+	provider.ConfigureContextFunc = func(ctx context.Context, d *schema.ResourceData) (interface{}, diag.Diagnostics) {
+		return configure(ctx, provider, d, localEndpoint)
+	}
+}
+
 // configure ensures that the provider is fully configured.
-func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData) (*conns.AWSClient, diag.Diagnostics) {
+func configure(ctx context.Context, provider *schema.Provider, d *schema.ResourceData, localEndpoint string) (*conns.AWSClient, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	terraformVersion := provider.TerraformVersion
@@ -476,6 +648,7 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
+		LocalEndpoint:                  localEndpoint,
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
@@ -511,6 +684,20 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.AllowedAccountIds = flex.ExpandStringValueSet(v.(*schema.Set))
 	}
 
+	if v, ok := d.GetOk("api_audit_log"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.APIAuditLog = expandAPIAuditLog(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
+	if v, ok := d.GetOk("api_rate_limit"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		apiRateLimit, err := expandAPIRateLimit(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.APIRateLimit = apiRateLimit
+	}
+
 	if v, ok := d.GetOk("assume_role"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
 		config.AssumeRole = expandAssumeRole(ctx, v.([]interface{})[0].(map[string]interface{}))
 		tflog.Info(ctx, "assume_role configuration set", map[string]any{
@@ -533,6 +720,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("tag_policy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		policyConfig, err := expandTagPolicy(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.TagPolicyConfig = policyConfig
+	}
+
 	if v, ok := d.GetOk("endpoints"); ok && v.(*schema.Set).Len() > 0 {
 		endpoints, err := expandEndpoints(ctx, v.(*schema.Set).List())
 
@@ -570,6 +767,16 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("retain_on_destroy"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		resourceTypes := expandRetainOnDestroy(ctx, v.([]interface{})[0].(map[string]interface{}))
+
+		if err := validateRetainOnDestroyResourceTypes(ctx, provider, resourceTypes); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+
+		config.RetainOnDestroyResourceTypes = resourceTypes
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -751,6 +958,79 @@ func endpointsSchema() *schema.Schema {
 	}
 }
 
+func expandAPIAuditLog(_ context.Context, tfMap map[string]interface{}) *conns.APIAuditLogConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &conns.APIAuditLogConfig{}
+
+	if v, ok := tfMap["format"].(string); ok && v != "" {
+		apiObject.Format = v
+	}
+
+	if v, ok := tfMap["path"].(string); ok && v != "" {
+		apiObject.Path = v
+	}
+
+	return apiObject
+}
+
+func expandAPIRateLimit(_ context.Context, tfMap map[string]interface{}) (*conns.APIRateLimitConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	apiRateLimit := &conns.APIRateLimitConfig{}
+
+	if v, ok := tfMap["burst"].(int); ok && v != 0 {
+		apiRateLimit.Burst = v
+	}
+
+	if v, ok := tfMap["circuit_breaker_cooldown"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		apiRateLimit.CircuitBreakerCooldown = duration
+	}
+
+	if v, ok := tfMap["circuit_breaker_threshold"].(int); ok && v != 0 {
+		apiRateLimit.CircuitBreakerThreshold = v
+	}
+
+	if v, ok := tfMap["requests_per_second"].(float64); ok && v != 0 {
+		apiRateLimit.RequestsPerSecond = v
+	}
+
+	if v, ok := tfMap["service_requests_per_second"].(map[string]interface{}); ok && len(v) > 0 {
+		apiRateLimit.ServiceRequestsPerSecond = make(map[string]float64)
+
+		for k, v := range v {
+			servicePackageName, err := apiRateLimitServicePackageName(k)
+
+			if err != nil {
+				return nil, err
+			}
+
+			apiRateLimit.ServiceRequestsPerSecond[servicePackageName] = v.(float64)
+		}
+	}
+
+	return apiRateLimit, nil
+}
+
+// apiRateLimitServicePackageName returns the service package name for a service_requests_per_second key.
+// Keys are service package names or any of their aliases.
+func apiRateLimitServicePackageName(key string) (string, error) {
+	if slices.Contains(names.ProviderPackages(), key) {
+		return key, nil
+	}
+
+	if v, err := names.ProviderPackageForAlias(key); err == nil {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("api_rate_limit: service_requests_per_second: unsupported service %q", key)
+}
+
 func expandAssumeRole(_ context.Context, tfMap map[string]interface{}) *awsbase.AssumeRole {
 	if tfMap == nil {
 		return nil
@@ -844,6 +1124,10 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 
 	defaultConfig := &tftags.DefaultConfig{}
 
+	if v, ok := tfMap["normalize_keys"].(bool); ok {
+		defaultConfig.NormalizeKeys = v
+	}
+
 	if v, ok := tfMap["tags"].(map[string]interface{}); ok {
 		defaultConfig.Tags = tftags.New(ctx, v)
 	}
@@ -869,6 +1153,98 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandRetainOnDestroy(_ context.Context, tfMap map[string]interface{}) []string {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["resource_types"].(*schema.Set); ok && v.Len() > 0 {
+		return flex.ExpandStringValueSet(v)
+	}
+
+	return nil
+}
+
+// validateRetainOnDestroyResourceTypes returns an error for each of the specified resource types
+// that is not a resource type registered by either the Plugin SDK or Plugin Framework provider.
+func validateRetainOnDestroyResourceTypes(ctx context.Context, provider *schema.Provider, resourceTypes []string) error {
+	var frameworkTypeNames []string
+	var errs []error
+
+	for _, typeName := range resourceTypes {
+		if _, ok := provider.ResourcesMap[typeName]; ok {
+			continue
+		}
+
+		// Plugin Framework resource type names are only known by instantiating the resources.
+		if frameworkTypeNames == nil {
+			var err error
+			frameworkTypeNames, err = frameworkResourceTypeNames(ctx, servicePackages(ctx))
+
+			if err != nil {
+				return err
+			}
+		}
+
+		if !slices.Contains(frameworkTypeNames, typeName) {
+			errs = append(errs, fmt.Errorf("retain_on_destroy: resource_types: unknown resource type: %s", typeName))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// frameworkResourceTypeNames returns the type names of all Plugin Framework resources in the specified service packages.
+func frameworkResourceTypeNames(ctx context.Context, servicePackages []conns.ServicePackage) ([]string, error) {
+	typeNames := make([]string, 0)
+
+	for _, sp := range servicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+
+			if err != nil {
+				return nil, fmt.Errorf("creating resource: %w", err)
+			}
+
+			response := resource.MetadataResponse{}
+			r.Metadata(ctx, resource.MetadataRequest{}, &response)
+			typeNames = append(typeNames, response.TypeName)
+		}
+	}
+
+	return typeNames, nil
+}
+
+func expandTagPolicy(ctx context.Context, tfMap map[string]interface{}) (*tftags.PolicyConfig, error) {
+	if tfMap == nil {
+		return nil, nil
+	}
+
+	policyConfig := &tftags.PolicyConfig{}
+
+	if v, ok := tfMap["allowed_values"].(map[string]interface{}); ok && len(v) > 0 {
+		policyConfig.AllowedValues = make(map[string]*regexp.Regexp)
+
+		for k, v := range v {
+			if _, errs := validation.StringIsValidRegExp(v, k); len(errs) > 0 {
+				return nil, fmt.Errorf("tag_policy: allowed_values (%s): %w", k, errors.Join(errs...))
+			}
+
+			policyConfig.AllowedValues[k] = regexache.MustCompile(v.(string))
+		}
+	}
+
+	if v, ok := tfMap["forbidden_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.ForbiddenKeys = flex.ExpandStringValueSet(v)
+	}
+
+	if v, ok := tfMap["required_keys"].(*schema.Set); ok && v.Len() > 0 {
+		policyConfig.RequiredKeys = flex.ExpandStringValueSet(v)
+	}
+
+	return policyConfig, nil
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, error) {
 	if len(tfList) == 0 {
 		return nil, nil
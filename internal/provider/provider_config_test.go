@@ -38,7 +38,7 @@ region = us-west-2
 `, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -51,7 +51,7 @@ region = us-west-2
 	`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -65,7 +65,7 @@ region = us-west-2
 		`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-west-2"; a != e {
+				if a, e := meta.Region(context.Background()), "us-west-2"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -85,7 +85,7 @@ region = us-west-2
 			`, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(context.Background()), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -104,7 +104,7 @@ region = us-east-1
 `, //lintignore:AWSAT003
 			Check: func(t *testing.T, meta *conns.AWSClient) {
 				//lintignore:AWSAT003
-				if a, e := meta.Region, "us-east-1"; a != e {
+				if a, e := meta.Region(context.Background()), "us-east-1"; a != e {
 					t.Errorf("expected region %q, got %q", e, a)
 				}
 			},
@@ -5,6 +5,7 @@ package provider
 
 import (
 	"context"
+	"maps"
 	"os"
 	"strings"
 	"testing"
@@ -205,3 +206,77 @@ func popEnv(env []string) {
 		os.Setenv(k, v)
 	}
 }
+
+func TestExpandAPIRateLimit(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	got, err := expandAPIRateLimit(ctx, map[string]interface{}{
+		"requests_per_second": 10.0,
+		"service_requests_per_second": map[string]interface{}{
+			"ec2":            2.0,
+			"cloudwatchlogs": 3.0,
+		},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := got.ServiceRequestsPerSecond, map[string]float64{names.EC2: 2, names.Logs: 3}; !maps.Equal(got, want) {
+		t.Errorf("ServiceRequestsPerSecond = %v, want %v", got, want)
+	}
+
+	_, err = expandAPIRateLimit(ctx, map[string]interface{}{
+		"service_requests_per_second": map[string]interface{}{
+			"notaservice": 1.0,
+		},
+	})
+	if err == nil {
+		t.Error("expected error for unknown service")
+	}
+}
+
+func TestValidateRetainOnDestroyResourceTypes(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	p, err := New(ctx)
+
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := map[string]struct {
+		resourceTypes []string
+		wantErr       bool
+	}{
+		"Plugin SDK resource": {
+			resourceTypes: []string{"aws_s3_bucket"},
+		},
+		"Plugin Framework resource": {
+			resourceTypes: []string{"aws_vpc_security_group_ingress_rule"},
+		},
+		"data source": {
+			resourceTypes: []string{"aws_s3_bucket", "aws_caller_identity"},
+			wantErr:       true,
+		},
+		"unknown resource": {
+			resourceTypes: []string{"aws_s3_buckets"},
+			wantErr:       true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := validateRetainOnDestroyResourceTypes(ctx, p, testCase.resourceTypes)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Errorf("err = %v, want error %t", err, want)
+			}
+		})
+	}
+}
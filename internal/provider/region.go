@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// injectRegionAttribute adds a top-level `region` attribute to the resource's or data source's schema.
+// false is returned if the schema already defines a `region` attribute.
+func injectRegionAttribute(r *schema.Resource, isDataSource bool) bool {
+	if _, ok := r.SchemaMap()[names.AttrRegion]; ok {
+		return false
+	}
+
+	attr := &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ForceNew:     !isDataSource,
+		ValidateFunc: verify.ValidRegionName,
+	}
+	if isDataSource {
+		attr.Description = "The AWS Region to use for this data source. Defaults to the Region set in the provider configuration."
+	} else {
+		attr.Description = "The AWS Region in which the resource is managed. Defaults to the Region set in the provider configuration."
+	}
+
+	if f := r.SchemaFunc; f != nil {
+		r.SchemaFunc = func() map[string]*schema.Schema {
+			m := f()
+			m[names.AttrRegion] = attr
+			return m
+		}
+	} else {
+		if r.Schema == nil {
+			r.Schema = make(map[string]*schema.Schema)
+		}
+		r.Schema[names.AttrRegion] = attr
+	}
+
+	return true
+}
+
+// regionCustomizeDiff plans the provider's Region for a new resource with no configured Region.
+func regionCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if d.Id() == "" {
+		if config := d.GetRawConfig(); config.IsKnown() && !config.IsNull() && config.GetAttr(names.AttrRegion).IsNull() {
+			return d.SetNew(names.AttrRegion, meta.(*conns.AWSClient).Region(ctx))
+		}
+	}
+
+	// Any other CustomizeDiff functions run with the Region override in Context.
+	if v, ok := d.Get(names.AttrRegion).(string); ok && v != "" {
+		if inContext, ok := conns.FromContext(ctx); ok {
+			inContext.Region = v
+		}
+	}
+
+	return nil
+}
+
+// regionImportStateFunc wraps a resource importer to support an optional "@<region>" suffix on the import ID.
+func regionImportStateFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if id, region, ok := conns.ParseRegionalImportID(d.Id()); ok {
+			d.SetId(id)
+			if err := d.Set(names.AttrRegion, region); err != nil {
+				return nil, err
+			}
+
+			if inContext, ok := conns.FromContext(ctx); ok {
+				inContext.Region = region
+			}
+		}
+
+		return f(ctx, d, meta)
+	}
+}
+
+// noRegionImportStateFunc wraps the importer of a resource that defines its own top-level `region` attribute.
+// Any "@<region>" suffix on the import ID is rejected rather than silently ignored.
+func noRegionImportStateFunc(f schema.StateContextFunc) schema.StateContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		if _, region, ok := conns.ParseRegionalImportID(d.Id()); ok {
+			return nil, fmt.Errorf("this resource does not support importing from a specific Region (%q); remove the \"@%[1]s\" suffix from the import ID", region)
+		}
+
+		return f(ctx, d, meta)
+	}
+}
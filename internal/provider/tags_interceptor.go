@@ -5,9 +5,11 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
@@ -17,6 +19,34 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
+// tagsPolicyCustomizeDiff fails the plan if a resource's tags, merged with any provider configured default_tags,
+// violate the provider configured tag_policy.
+func tagsPolicyCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	inContext, ok := conns.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	tagsInContext, ok := tftags.FromContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	// Unknown tags are only checked once known.
+	if !d.GetRawPlan().GetAttr(names.AttrTags).IsWhollyKnown() {
+		return nil
+	}
+
+	// Calculate tags_all as verify.SetTagsDiff does.
+	tags := tagsInContext.DefaultConfig.MergeTags(tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))).IgnoreConfig(tagsInContext.IgnoreConfig)
+
+	if err := tagsInContext.PolicyConfig.Validate(tags); err != nil {
+		return fmt.Errorf("%s %s violates provider tag_policy: %w", inContext.TypeName, names.AttrTagsAll, err)
+	}
+
+	return nil
+}
+
 func tagsUpdateFunc(ctx context.Context, d schemaResourceData, sp conns.ServicePackage, spt *types.ServicePackageResourceTags, serviceName, resourceName string, meta any, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	inContext, ok := conns.FromContext(ctx)
 	if !ok {
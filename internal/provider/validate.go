@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
@@ -37,3 +39,23 @@ var validAssumeRoleSourceIdentity = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
 )
+
+// validTagPolicyAllowedValues validates that each value of a tag_policy allowed_values map is a valid regular expression.
+func validTagPolicyAllowedValues(v interface{}, path cty.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	for k, v := range v.(map[string]interface{}) {
+		_, errs := validation.StringIsValidRegExp(v, k)
+
+		for _, err := range errs {
+			diags = append(diags, diag.Diagnostic{
+				Severity:      diag.Error,
+				Summary:       "Invalid regular expression",
+				Detail:        err.Error(),
+				AttributePath: path.IndexString(k),
+			})
+		}
+	}
+
+	return diags
+}
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/go-cty/cty"
 )
 
 func TestValidAssumeRoleDuration(t *testing.T) {
@@ -73,3 +74,42 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidTagPolicyAllowedValues(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		val       map[string]interface{}
+		wantError bool
+	}{
+		"empty": {
+			val: map[string]interface{}{},
+		},
+		"valid": {
+			val: map[string]interface{}{
+				"env":   `^(dev|prod)$`,
+				"owner": `.+`,
+			},
+		},
+		"invalid": {
+			val: map[string]interface{}{
+				"env":   `^(dev|prod$`,
+				"owner": `.+`,
+			},
+			wantError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := validTagPolicyAllowedValues(testCase.val, cty.GetAttrPath("allowed_values"))
+
+			if got, want := diags.HasError(), testCase.wantError; got != want {
+				t.Errorf("HasError = %t, want %t: %v", got, want, diags)
+			}
+		})
+	}
+}
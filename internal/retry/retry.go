@@ -16,7 +16,8 @@ import (
 // Before the ith iteration of the loop, retry.Continue() sleeps for a duraion of BackoffMinDuration * BackoffMultiplier**i, with added jitter.
 type Options struct {
 	BackoffMinDuration time.Duration
-	BackoffMultiplier  float64 // If specified, must be at least 1.
+	BackoffMaxDuration time.Duration // If specified, caps the sleep duration before jitter is added.
+	BackoffMultiplier  float64       // If specified, must be at least 1.
 }
 
 var defaultOptions = Options{
@@ -60,7 +61,11 @@ func (r *Retry) Reset() {
 
 func (r *Retry) backoffDelay() time.Duration {
 	mult := math.Pow(r.options.BackoffMultiplier, float64(r.attempt))
-	return time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	d := time.Duration(float64(r.options.BackoffMinDuration) * mult)
+	if v := r.options.BackoffMaxDuration; v > 0 && (d > v || d < 0) {
+		d = v
+	}
+	return d
 }
 
 // Do not use the default RNG since we do not want different provider instances
@@ -106,3 +106,25 @@ func TestRandomization(t *testing.T) {
 		t.Errorf("sleep interval was too consistent (+- %.1f%%)", stdDevFraction*100)
 	}
 }
+
+func TestBackoffMaxDuration(t *testing.T) {
+	t.Parallel()
+
+	r := BeginWithOptions(Options{
+		BackoffMinDuration: time.Second,
+		BackoffMaxDuration: 10 * time.Second,
+		BackoffMultiplier:  2,
+	})
+	for i, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second} {
+		r.attempt = i
+		if got := r.backoffDelay(); got != want {
+			t.Errorf("attempt %d: got backoff delay %v, expected %v", i, got, want)
+		}
+	}
+
+	// Overflow is capped too.
+	r.attempt = 1000
+	if got, want := r.backoffDelay(), 10*time.Second; got != want {
+		t.Errorf("attempt %d: got backoff delay %v, expected %v", r.attempt, got, want)
+	}
+}
@@ -9,7 +9,7 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	sdkretry "github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
 
 type Op[T any] interface {
@@ -82,7 +82,7 @@ func (o operation[T]) UntilFoundN(continuousTargetOccurence int) operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if notFound(err) {
 			targetOccurence = 0
 
 			return true, err
@@ -100,7 +100,7 @@ func (o operation[T]) UntilNotFound() operation[T] {
 			return true, nil
 		}
 
-		if tfresource.NotFound(err) {
+		if notFound(err) {
 			return false, nil
 		}
 
@@ -134,3 +134,10 @@ func (o operation[T]) Run(ctx context.Context, timeout time.Duration) (T, error)
 	var t T
 	return t, o.transformRunError(ctx.Err())
 }
+
+// notFound returns true if the error represents a "resource not found" condition.
+// It mirrors tfresource.NotFound, which cannot be used here as tfresource depends on this package.
+func notFound(err error) bool {
+	var e *sdkretry.NotFoundError // nosemgrep:ci.is-not-found-error
+	return errors.As(err, &e)
+}
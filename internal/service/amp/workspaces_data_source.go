@@ -61,7 +61,7 @@ func dataSourceWorkspacesRead(ctx context.Context, d *schema.ResourceData, meta
 		workspace_ids = append(workspace_ids, aws.StringValue(w.WorkspaceId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("aliases", aliases)
 	d.Set("arns", arns)
 	d.Set("workspace_ids", workspace_ids)
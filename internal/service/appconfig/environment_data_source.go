@@ -101,7 +101,7 @@ func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta
 		return create.AppendDiagError(diags, names.AppConfig, create.ErrActionReading, DSNameEnvironment, ID, err)
 	}
 
-	arn := environmentARN(meta.(*conns.AWSClient), appID, envID).String()
+	arn := environmentARN(ctx, meta.(*conns.AWSClient), appID, envID).String()
 
 	d.Set("arn", arn)
 
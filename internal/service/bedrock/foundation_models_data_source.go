@@ -110,7 +110,7 @@ func (d *dataSourceFoundationModels) Read(ctx context.Context, request datasourc
 	if response.Diagnostics.HasError() {
 		return
 	}
-	data.ID = types.StringValue(d.Meta().Region)
+	data.ID = types.StringValue(d.Meta().Region(ctx))
 
 	input := &bedrock.ListFoundationModelsInput{}
 	if !data.ByCustomizationType.IsNull() {
@@ -109,7 +109,7 @@ func (r *resourceModelInvocationLoggingConfiguration) Create(ctx context.Context
 	if resp.Diagnostics.HasError() {
 		return
 	}
-	data.ID = flex.StringValueToFramework(ctx, r.Meta().Region)
+	data.ID = flex.StringValueToFramework(ctx, r.Meta().Region(ctx))
 
 	loggingConfig := expandLoggingConfig(ctx, data.LoggingConfig, resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Capacity Block Offering")
+func newDataSourceCapacityBlockOffering(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceCapacityBlockOffering{}, nil
+}
+
+type dataSourceCapacityBlockOffering struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceCapacityBlockOffering) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_ec2_capacity_block_offering"
+}
+
+func (d *dataSourceCapacityBlockOffering) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"availability_zone": schema.StringAttribute{
+				Computed: true,
+			},
+			"capacity_block_offering_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"capacity_duration_hours": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"currency_code": schema.StringAttribute{
+				Computed: true,
+			},
+			"end_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+			},
+			"end_date_range": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Optional:   true,
+			},
+			"id": framework.IDAttribute(),
+			"instance_count": schema.Int64Attribute{
+				Required: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"instance_type": schema.StringAttribute{
+				Required: true,
+			},
+			"start_date": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Computed:   true,
+			},
+			"start_date_range": schema.StringAttribute{
+				CustomType: fwtypes.TimestampType,
+				Optional:   true,
+			},
+			"tenancy": schema.StringAttribute{
+				Computed: true,
+			},
+			"upfront_fee": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *dataSourceCapacityBlockOffering) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceCapacityBlockOfferingModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Conn(ctx)
+
+	input := &ec2.DescribeCapacityBlockOfferingsInput{
+		CapacityDurationHours: aws.Int64(data.CapacityDurationHours.ValueInt64()),
+		InstanceCount:         aws.Int64(data.InstanceCount.ValueInt64()),
+		InstanceType:          aws.String(data.InstanceType.ValueString()),
+	}
+
+	if !data.EndDateRange.IsNull() {
+		input.EndDateRange = aws.Time(data.EndDateRange.ValueTimestamp())
+	}
+
+	if !data.StartDateRange.IsNull() {
+		input.StartDateRange = aws.Time(data.StartDateRange.ValueTimestamp())
+	}
+
+	output, err := findCapacityBlockOfferings(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EC2 Capacity Block Offerings", err.Error())
+
+		return
+	}
+
+	// Return the offering with the earliest start date.
+	var offering *ec2.CapacityBlockOffering
+	for _, v := range output {
+		if offering == nil || aws.TimeValue(v.StartDate).Before(aws.TimeValue(offering.StartDate)) {
+			offering = v
+		}
+	}
+
+	if offering == nil {
+		response.Diagnostics.AddError("reading EC2 Capacity Block Offerings", tfresource.NewEmptyResultError(input).Error())
+
+		return
+	}
+
+	data.AvailabilityZone = types.StringPointerValue(offering.AvailabilityZone)
+	data.CapacityBlockOfferingID = types.StringPointerValue(offering.CapacityBlockOfferingId)
+	data.CurrencyCode = types.StringPointerValue(offering.CurrencyCode)
+	data.EndDate = fwtypes.TimestampValue(aws.TimeValue(offering.EndDate).Format(time.RFC3339))
+	data.ID = types.StringPointerValue(offering.CapacityBlockOfferingId)
+	data.StartDate = fwtypes.TimestampValue(aws.TimeValue(offering.StartDate).Format(time.RFC3339))
+	data.Tenancy = types.StringPointerValue(offering.Tenancy)
+	data.UpfrontFee = types.StringPointerValue(offering.UpfrontFee)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceCapacityBlockOfferingModel struct {
+	AvailabilityZone        types.String      `tfsdk:"availability_zone"`
+	CapacityBlockOfferingID types.String      `tfsdk:"capacity_block_offering_id"`
+	CapacityDurationHours   types.Int64       `tfsdk:"capacity_duration_hours"`
+	CurrencyCode            types.String      `tfsdk:"currency_code"`
+	EndDate                 fwtypes.Timestamp `tfsdk:"end_date"`
+	EndDateRange            fwtypes.Timestamp `tfsdk:"end_date_range"`
+	ID                      types.String      `tfsdk:"id"`
+	InstanceCount           types.Int64       `tfsdk:"instance_count"`
+	InstanceType            types.String      `tfsdk:"instance_type"`
+	StartDate               fwtypes.Timestamp `tfsdk:"start_date"`
+	StartDateRange          fwtypes.Timestamp `tfsdk:"start_date_range"`
+	Tenancy                 types.String      `tfsdk:"tenancy"`
+	UpfrontFee              types.String      `tfsdk:"upfront_fee"`
+}
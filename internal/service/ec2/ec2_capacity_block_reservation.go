@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Capacity Block Reservation")
+// @Tags(identifierAttribute="id")
+func newResourceCapacityBlockReservation(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceCapacityBlockReservation{}
+	r.SetDefaultCreateTimeout(10 * time.Minute)
+
+	return r, nil
+}
+
+type resourceCapacityBlockReservation struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *resourceCapacityBlockReservation) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_ec2_capacity_block_reservation"
+}
+
+func (r *resourceCapacityBlockReservation) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	computedTimestamp := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			CustomType: fwtypes.TimestampType,
+			Computed:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"arn":               computedString(),
+			"availability_zone": computedString(),
+			// Offering IDs change as offerings move on, so a change after purchase is recorded in place rather than buying another Capacity Block.
+			"capacity_block_offering_id": schema.StringAttribute{
+				Required: true,
+			},
+			"created_date":  computedTimestamp(),
+			"end_date":      computedTimestamp(),
+			"end_date_type": computedString(),
+			names.AttrID:    framework.IDAttribute(),
+			"instance_count": schema.Int64Attribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"instance_platform": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(ec2.CapacityReservationInstancePlatform_Values()...),
+				},
+			},
+			"instance_type":    computedString(),
+			"owner_id":         computedString(),
+			"reservation_type": computedString(),
+			"start_date":       computedTimestamp(),
+			"state":            computedString(),
+			names.AttrTags:     tftags.TagsAttribute(),
+			names.AttrTagsAll:  tftags.TagsAttributeComputedOnly(),
+			"tenancy":          computedString(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *resourceCapacityBlockReservation) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data resourceCapacityBlockReservationModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	input := &ec2.PurchaseCapacityBlockInput{
+		CapacityBlockOfferingId: aws.String(data.CapacityBlockOfferingID.ValueString()),
+		InstancePlatform:        aws.String(data.InstancePlatform.ValueString()),
+		TagSpecifications:       getTagSpecificationsIn(ctx, ec2.ResourceTypeCapacityReservation),
+	}
+
+	output, err := conn.PurchaseCapacityBlockWithContext(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("purchasing EC2 Capacity Block (%s)", data.CapacityBlockOfferingID.ValueString()), err.Error())
+
+		return
+	}
+
+	id := aws.StringValue(output.CapacityReservation.CapacityReservationId)
+	data.ID = types.StringValue(id)
+
+	capacityReservation, err := waitCapacityBlockReservationPurchased(ctx, conn, id, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for EC2 Capacity Block Reservation (%s) create", id), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(capacityReservation)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data resourceCapacityBlockReservationModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().EC2Conn(ctx)
+
+	capacityReservation, err := findCapacityBlockReservationByID(ctx, conn, data.ID.ValueString())
+
+	// Reservations are eventually deleted after they end. Keep an ended Capacity Block in state so that it isn't purchased again.
+	if tfresource.NotFound(err) {
+		if v := data.EndDate; !v.IsNull() && !v.IsUnknown() && v.ValueTimestamp().Before(time.Now()) {
+			data.State = types.StringValue(ec2.CapacityReservationStateExpired)
+			response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+
+			return
+		}
+
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Capacity Block Reservation (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	data.refreshFromOutput(capacityReservation)
+
+	setTagsOut(ctx, capacityReservation.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var data resourceCapacityBlockReservationModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Tags and capacity_block_offering_id only. A changed offering ID is recorded without purchasing another Capacity Block.
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *resourceCapacityBlockReservation) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data resourceCapacityBlockReservationModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Capacity Blocks cannot be canceled.
+	response.Diagnostics.AddWarning(
+		"EC2 Capacity Block Reservation is non-refundable",
+		fmt.Sprintf("EC2 Capacity Block Reservation (%s) has been removed from Terraform state, but it cannot be canceled and its upfront fee will not be refunded. "+
+			"The reservation remains in your account until its end date (%s).", data.ID.ValueString(), data.EndDate.ValueString()),
+	)
+}
+
+func (r *resourceCapacityBlockReservation) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Warn before a purchased Capacity Block is destroyed or replaced, as neither refunds it.
+	if !request.State.Raw.IsNull() {
+		var id types.String
+		response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if request.Plan.Raw.IsNull() {
+			response.Diagnostics.AddWarning(
+				"EC2 Capacity Block Reservation is non-refundable",
+				fmt.Sprintf("Destroying EC2 Capacity Block Reservation (%s) only removes it from Terraform state. "+
+					"It cannot be canceled and its upfront fee will not be refunded.", id.ValueString()),
+			)
+
+			return
+		}
+
+		if len(response.RequiresReplace) > 0 {
+			response.Diagnostics.AddWarning(
+				"EC2 Capacity Block Reservation is non-refundable",
+				fmt.Sprintf("Replacing EC2 Capacity Block Reservation (%s) purchases a new Capacity Block and charges its upfront fee. "+
+					"The existing reservation cannot be canceled and its upfront fee will not be refunded.", id.ValueString()),
+			)
+		}
+	}
+
+	r.SetTagsAll(ctx, request, response)
+}
+
+type resourceCapacityBlockReservationModel struct {
+	ARN                     types.String      `tfsdk:"arn"`
+	AvailabilityZone        types.String      `tfsdk:"availability_zone"`
+	CapacityBlockOfferingID types.String      `tfsdk:"capacity_block_offering_id"`
+	CreatedDate             fwtypes.Timestamp `tfsdk:"created_date"`
+	EndDate                 fwtypes.Timestamp `tfsdk:"end_date"`
+	EndDateType             types.String      `tfsdk:"end_date_type"`
+	ID                      types.String      `tfsdk:"id"`
+	InstanceCount           types.Int64       `tfsdk:"instance_count"`
+	InstancePlatform        types.String      `tfsdk:"instance_platform"`
+	InstanceType            types.String      `tfsdk:"instance_type"`
+	OwnerID                 types.String      `tfsdk:"owner_id"`
+	ReservationType         types.String      `tfsdk:"reservation_type"`
+	StartDate               fwtypes.Timestamp `tfsdk:"start_date"`
+	State                   types.String      `tfsdk:"state"`
+	Tags                    types.Map         `tfsdk:"tags"`
+	TagsAll                 types.Map         `tfsdk:"tags_all"`
+	Tenancy                 types.String      `tfsdk:"tenancy"`
+	Timeouts                timeouts.Value    `tfsdk:"timeouts"`
+}
+
+func (data *resourceCapacityBlockReservationModel) refreshFromOutput(apiObject *ec2.CapacityReservation) {
+	data.ARN = types.StringPointerValue(apiObject.CapacityReservationArn)
+	data.AvailabilityZone = types.StringPointerValue(apiObject.AvailabilityZone)
+	data.CreatedDate = timestampValueFromTime(apiObject.CreateDate)
+	data.EndDate = timestampValueFromTime(apiObject.EndDate)
+	data.EndDateType = types.StringPointerValue(apiObject.EndDateType)
+	data.ID = types.StringPointerValue(apiObject.CapacityReservationId)
+	data.InstanceCount = types.Int64PointerValue(apiObject.TotalInstanceCount)
+	data.InstancePlatform = types.StringPointerValue(apiObject.InstancePlatform)
+	data.InstanceType = types.StringPointerValue(apiObject.InstanceType)
+	data.OwnerID = types.StringPointerValue(apiObject.OwnerId)
+	data.ReservationType = types.StringPointerValue(apiObject.ReservationType)
+	data.StartDate = timestampValueFromTime(apiObject.StartDate)
+	data.State = types.StringPointerValue(apiObject.State)
+	data.Tenancy = types.StringPointerValue(apiObject.Tenancy)
+}
+
+func timestampValueFromTime(v *time.Time) fwtypes.Timestamp {
+	if v == nil {
+		return fwtypes.TimestampNull()
+	}
+
+	return fwtypes.TimestampValue(v.Format(time.RFC3339))
+}
@@ -4,9 +4,12 @@
 package ec2
 
 import (
+	"cmp"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"time"
 
@@ -23,6 +26,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/create"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/nullable"
@@ -659,6 +663,11 @@ func ResourceLaunchTemplate() *schema.Resource {
 					},
 				},
 			},
+			"max_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 			"metadata_options": {
 				Type:     schema.TypeList,
 				Optional: true,
@@ -952,7 +961,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("default_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description":
+					case "name", "name_prefix", "description", "max_versions":
 						continue
 					default:
 						return diff.Get("update_default_version").(bool)
@@ -963,7 +972,7 @@ func ResourceLaunchTemplate() *schema.Resource {
 			customdiff.ComputedIf("latest_version", func(_ context.Context, diff *schema.ResourceDiff, meta interface{}) bool {
 				for _, changedKey := range diff.GetChangedKeysPrefix("") {
 					switch changedKey {
-					case "name", "name_prefix", "description", "default_version", "update_default_version":
+					case "name", "name_prefix", "description", "default_version", "max_versions", "update_default_version":
 						continue
 					default:
 						return true
@@ -1005,6 +1014,12 @@ func resourceLaunchTemplateCreate(ctx context.Context, d *schema.ResourceData, m
 
 	d.SetId(aws.StringValue(output.LaunchTemplate.LaunchTemplateId))
 
+	if v, ok := d.GetOk("max_versions"); ok {
+		if err := deleteLaunchTemplateVersionsBeyond(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceLaunchTemplateRead(ctx, d, meta)...)
 }
 
@@ -1024,17 +1039,16 @@ func resourceLaunchTemplateRead(ctx context.Context, d *schema.ResourceData, met
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s): %s", d.Id(), err)
 	}
 
-	version := strconv.FormatInt(aws.Int64Value(lt.LatestVersionNumber), 10)
-	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, d.Id(), version)
+	ltv, err := findLatestLaunchTemplateVersionNotManagedByVersionResource(ctx, conn, lt)
 
 	if err != nil {
-		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) Version (%s): %s", d.Id(), version, err)
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template (%s) latest Version: %s", d.Id(), err)
 	}
 
 	arn := arn.ARN{
 		Partition: meta.(*conns.AWSClient).Partition,
 		Service:   ec2.ServiceName,
-		Region:    meta.(*conns.AWSClient).Region,
+		Region:    meta.(*conns.AWSClient).Region(ctx),
 		AccountID: meta.(*conns.AWSClient).AccountID,
 		Resource:  fmt.Sprintf("launch-template/%s", d.Id()),
 	}.String()
@@ -1136,6 +1150,13 @@ func resourceLaunchTemplateUpdate(ctx context.Context, d *schema.ResourceData, m
 		}
 	}
 
+	// Prune old versions only after any new default version has been set, so that it isn't deleted.
+	if v, ok := d.GetOk("max_versions"); ok && (d.HasChanges(updateKeys...) || d.HasChange("max_versions")) {
+		if err := deleteLaunchTemplateVersionsBeyond(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
 	return append(diags, resourceLaunchTemplateRead(ctx, d, meta)...)
 }
 
@@ -1159,6 +1180,147 @@ func resourceLaunchTemplateDelete(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+// findLatestLaunchTemplateVersionNotManagedByVersionResource returns the latest version of the specified launch template
+// that was not created by aws_launch_template_version.
+// Versions are read newest-first in bounded windows, stopping at the first such version.
+// If there is no such version, the latest version is returned.
+func findLatestLaunchTemplateVersionNotManagedByVersionResource(ctx context.Context, conn *ec2.EC2, lt *ec2.LaunchTemplate) (*ec2.LaunchTemplateVersion, error) {
+	const (
+		windowSize = 20
+	)
+
+	id := aws.StringValue(lt.LaunchTemplateId)
+	latestVersion := aws.Int64Value(lt.LatestVersionNumber)
+	latest, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, id, strconv.FormatInt(latestVersion, 10))
+
+	if err != nil {
+		return nil, err
+	}
+
+	if !isLaunchTemplateVersionManagedByVersionResource(latest) {
+		return latest, nil
+	}
+
+	for maxVersion := latestVersion - 1; maxVersion >= 1; maxVersion -= windowSize {
+		minVersion := max(maxVersion-windowSize+1, 1)
+		input := &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(id),
+			MaxResults:       aws.Int64(windowSize),
+			MaxVersion:       aws.String(strconv.FormatInt(maxVersion, 10)),
+			MinVersion:       aws.String(strconv.FormatInt(minVersion, 10)),
+		}
+
+		output, err := FindLaunchTemplateVersions(ctx, conn, input)
+
+		// All versions in the window have been deleted.
+		if tfresource.NotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		sortLaunchTemplateVersionsNewestFirst(output)
+
+		for _, v := range output {
+			if !isLaunchTemplateVersionManagedByVersionResource(v) {
+				return v, nil
+			}
+		}
+	}
+
+	return latest, nil
+}
+
+// findLaunchTemplateVersionsNewestFirst returns all versions of the specified launch template, sorted by version number in descending order.
+func findLaunchTemplateVersionsNewestFirst(ctx context.Context, conn *ec2.EC2, id string) ([]*ec2.LaunchTemplateVersion, error) {
+	input := &ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(id),
+	}
+
+	output, err := FindLaunchTemplateVersions(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sortLaunchTemplateVersionsNewestFirst(output)
+
+	return output, nil
+}
+
+func sortLaunchTemplateVersionsNewestFirst(versions []*ec2.LaunchTemplateVersion) {
+	slices.SortFunc(versions, func(a, b *ec2.LaunchTemplateVersion) int {
+		return cmp.Compare(aws.Int64Value(b.VersionNumber), aws.Int64Value(a.VersionNumber))
+	})
+}
+
+// deleteLaunchTemplateVersionsBeyond deletes the oldest versions of the specified launch template so that at most maxVersions remain.
+// The default version and versions created by aws_launch_template_version are never deleted and are not counted.
+func deleteLaunchTemplateVersionsBeyond(ctx context.Context, conn *ec2.EC2, id string, maxVersions int) error {
+	output, err := findLaunchTemplateVersionsNewestFirst(ctx, conn, id)
+
+	if err != nil {
+		return fmt.Errorf("reading EC2 Launch Template (%s) Versions: %w", id, err)
+	}
+
+	output = tfslices.Filter(output, func(v *ec2.LaunchTemplateVersion) bool {
+		return !isLaunchTemplateVersionManagedByVersionResource(v)
+	})
+
+	if len(output) <= maxVersions {
+		return nil
+	}
+
+	var versions []int64
+	for i, v := range output {
+		if i < maxVersions || aws.BoolValue(v.DefaultVersion) {
+			continue
+		}
+
+		versions = append(versions, aws.Int64Value(v.VersionNumber))
+	}
+
+	return deleteLaunchTemplateVersions(ctx, conn, id, versions)
+}
+
+func deleteLaunchTemplateVersions(ctx context.Context, conn *ec2.EC2, id string, versions []int64) error {
+	const (
+		chunkSize = 200 // API limit.
+	)
+
+	var errs []error
+	for _, chunk := range tfslices.Chunks(versions, chunkSize) {
+		input := &ec2.DeleteLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(id),
+			Versions: tfslices.ApplyToAll(chunk, func(v int64) *string {
+				return aws.String(strconv.FormatInt(v, 10))
+			}),
+		}
+
+		output, err := conn.DeleteLaunchTemplateVersionsWithContext(ctx, input)
+
+		if tfawserr.ErrCodeEquals(err, errCodeInvalidLaunchTemplateIdNotFound) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting EC2 Launch Template (%s) Versions: %w", id, err)
+		}
+
+		for _, v := range output.UnsuccessfullyDeletedLaunchTemplateVersions {
+			if v.ResponseError == nil || aws.StringValue(v.ResponseError.Code) == ec2.LaunchTemplateErrorCodeLaunchTemplateVersionDoesNotExist {
+				continue
+			}
+
+			errs = append(errs, fmt.Errorf("deleting EC2 Launch Template (%s) Version (%d): %s: %s", id, aws.Int64Value(v.VersionNumber), aws.StringValue(v.ResponseError.Code), aws.StringValue(v.ResponseError.Message)))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
 func expandRequestLaunchTemplateData(ctx context.Context, conn *ec2.EC2, d *schema.ResourceData) (*ec2.RequestLaunchTemplateData, error) {
 	apiObject := &ec2.RequestLaunchTemplateData{
 		// Always set at least one field.
@@ -10,6 +10,7 @@ import (
 	"testing"
 
 	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -3182,6 +3183,103 @@ func TestAccEC2LaunchTemplate_updateDefaultVersion(t *testing.T) {
 	})
 }
 
+func TestAccEC2LaunchTemplate_maxVersions(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	resourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_maxVersions(rName, "t2.micro", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 1),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"max_versions"},
+			},
+			{
+				Config: testAccLaunchTemplateConfig_maxVersions(rName, "t2.small", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+				),
+			},
+			{
+				// Version 2 is deleted. The default version is retained.
+				Config: testAccLaunchTemplateConfig_maxVersions(rName, "t2.medium", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplate_maxVersionsWithVersionResource(t *testing.T) {
+	ctx := acctest.Context(t)
+	var template ec2.LaunchTemplate
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template.test"
+	versionResourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateConfig_maxVersionsWithVersionResource(rName, "t2.micro"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionExists(ctx, versionResourceName, &v),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 2),
+					resource.TestCheckResourceAttr(versionResourceName, "version_number", "2"),
+				),
+			},
+			{
+				Config: testAccLaunchTemplateConfig_maxVersionsWithVersionResource(rName, "t2.small"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.small"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+				),
+			},
+			{
+				// Version 3 is deleted. The default version and the version managed by aws_launch_template_version are retained.
+				Config: testAccLaunchTemplateConfig_maxVersionsWithVersionResource(rName, "t2.medium"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateExists(ctx, resourceName, &template),
+					testAccCheckLaunchTemplateVersionExists(ctx, versionResourceName, &v),
+					testAccCheckLaunchTemplateVersionCount(ctx, resourceName, 3),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "instance_type", "t2.medium"),
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "4"),
+					resource.TestCheckResourceAttr(versionResourceName, "version_number", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckLaunchTemplateExists(ctx context.Context, n string, v *ec2.LaunchTemplate) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -3233,6 +3331,31 @@ func testAccCheckLaunchTemplateDestroy(ctx context.Context) resource.TestCheckFu
 	}
 }
 
+func testAccCheckLaunchTemplateVersionCount(ctx context.Context, n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersions(ctx, conn, &ec2.DescribeLaunchTemplateVersionsInput{
+			LaunchTemplateId: aws.String(rs.Primary.ID),
+		})
+
+		if err != nil {
+			return err
+		}
+
+		if got := len(output); got != want {
+			return fmt.Errorf("EC2 Launch Template (%s) version count = %d, want %d", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccLaunchTemplateConfig_name(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_launch_template" "test" {
@@ -4187,3 +4310,32 @@ resource "aws_launch_template" "test" {
 }
 `, rName, description, update)
 }
+
+func testAccLaunchTemplateConfig_maxVersions(rName, instanceType string, maxVersions int) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+  max_versions  = %[3]d
+}
+`, rName, instanceType, maxVersions)
+}
+
+func testAccLaunchTemplateConfig_maxVersionsWithVersionResource(rName, instanceType string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = %[2]q
+  max_versions  = 1
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = "1"
+
+  launch_template_data = jsonencode({
+    KeyName = %[1]q
+  })
+}
+`, rName, instanceType)
+}
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/structure"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+)
+
+// @SDKResource("aws_launch_template_version", name="Launch Template Version")
+func resourceLaunchTemplateVersion() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceLaunchTemplateVersionCreate,
+		ReadWithoutTimeout:   resourceLaunchTemplateVersionRead,
+		UpdateWithoutTimeout: resourceLaunchTemplateVersionUpdate,
+		DeleteWithoutTimeout: resourceLaunchTemplateVersionDelete,
+
+		// Import is not supported: launch_template_data and source_version can't be read back, and
+		// only versions created by this resource are protected from the launch template's max_versions.
+
+		Schema: map[string]*schema.Schema{
+			"create_time": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_by": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"default_version": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				Computed: true,
+			},
+			"launch_template_data": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				DiffSuppressFunc: verify.SuppressEquivalentJSONDiffs,
+				StateFunc: func(v interface{}) string {
+					json, _ := structure.NormalizeJsonString(v)
+					return json
+				},
+			},
+			"launch_template_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"source_version": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"version_description": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(0, 255-len(launchTemplateVersionDescriptionMarker)-1),
+			},
+			"version_number": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+		},
+	}
+}
+
+const (
+	launchTemplateVersionResourceIDPartCount = 2
+
+	// launchTemplateVersionDescriptionMarker is appended to the description of versions created by this resource.
+	// aws_launch_template ignores marked versions when reading its own configuration and when deleting versions beyond max_versions.
+	launchTemplateVersionDescriptionMarker = "[terraform:aws_launch_template_version]"
+)
+
+func resourceLaunchTemplateVersionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	input := &ec2.CreateLaunchTemplateVersionInput{
+		ClientToken:        aws.String(id.UniqueId()),
+		LaunchTemplateData: &ec2.RequestLaunchTemplateData{},
+		LaunchTemplateId:   aws.String(launchTemplateID),
+		VersionDescription: aws.String(addLaunchTemplateVersionDescriptionMarker(d.Get("version_description").(string))),
+	}
+
+	// The launch template data uses the same format as the AWS CLI's --launch-template-data parameter.
+	if v, ok := d.GetOk("launch_template_data"); ok {
+		decoder := json.NewDecoder(strings.NewReader(v.(string)))
+		decoder.DisallowUnknownFields()
+
+		if err := decoder.Decode(input.LaunchTemplateData); err != nil {
+			return sdkdiag.AppendErrorf(diags, "decoding launch_template_data: %s", err)
+		}
+	}
+
+	if v, ok := d.GetOk("source_version"); ok {
+		input.SourceVersion = aws.String(v.(string))
+	}
+
+	output, err := conn.CreateLaunchTemplateVersionWithContext(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating EC2 Launch Template (%s) Version: %s", launchTemplateID, err)
+	}
+
+	version := strconv.FormatInt(aws.Int64Value(output.LaunchTemplateVersion.VersionNumber), 10)
+	resourceID, err := flex.FlattenResourceId([]string{launchTemplateID, version}, launchTemplateVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(resourceID)
+
+	if d.Get("default_version").(bool) {
+		if err := modifyLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), launchTemplateVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	launchTemplateID, version := parts[0], parts[1]
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EC2 Launch Template Version (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	d.Set("create_time", aws.TimeValue(ltv.CreateTime).Format(time.RFC3339))
+	d.Set("created_by", ltv.CreatedBy)
+	d.Set("default_version", ltv.DefaultVersion)
+	d.Set("launch_template_id", ltv.LaunchTemplateId)
+	d.Set("version_description", removeLaunchTemplateVersionDescriptionMarker(aws.StringValue(ltv.VersionDescription)))
+	d.Set("version_number", ltv.VersionNumber)
+
+	return diags
+}
+
+func resourceLaunchTemplateVersionUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// A launch template always has a default version, so only setting the default is supported.
+	if d.HasChange("default_version") && d.Get("default_version").(bool) {
+		launchTemplateID := d.Get("launch_template_id").(string)
+		version := strconv.Itoa(d.Get("version_number").(int))
+
+		if err := modifyLaunchTemplateDefaultVersion(ctx, conn, launchTemplateID, version); err != nil {
+			return sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	return append(diags, resourceLaunchTemplateVersionRead(ctx, d, meta)...)
+}
+
+func resourceLaunchTemplateVersionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	launchTemplateID := d.Get("launch_template_id").(string)
+	version := int64(d.Get("version_number").(int))
+
+	ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, strconv.FormatInt(version, 10))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Launch Template Version (%s): %s", d.Id(), err)
+	}
+
+	// The default version of a launch template can't be deleted. It's deleted along with the launch template.
+	if aws.BoolValue(ltv.DefaultVersion) {
+		return sdkdiag.AppendWarningf(diags, "EC2 Launch Template Version (%s) is the default version and has not been deleted", d.Id())
+	}
+
+	log.Printf("[DEBUG] Deleting EC2 Launch Template Version: %s", d.Id())
+	if err := deleteLaunchTemplateVersions(ctx, conn, launchTemplateID, []int64{version}); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	return diags
+}
+
+func modifyLaunchTemplateDefaultVersion(ctx context.Context, conn *ec2.EC2, id, version string) error {
+	input := &ec2.ModifyLaunchTemplateInput{
+		DefaultVersion:   aws.String(version),
+		LaunchTemplateId: aws.String(id),
+	}
+
+	if _, err := conn.ModifyLaunchTemplateWithContext(ctx, input); err != nil {
+		return fmt.Errorf("setting EC2 Launch Template (%s) default version (%s): %w", id, version, err)
+	}
+
+	return nil
+}
+
+func addLaunchTemplateVersionDescriptionMarker(description string) string {
+	if description == "" {
+		return launchTemplateVersionDescriptionMarker
+	}
+
+	return description + " " + launchTemplateVersionDescriptionMarker
+}
+
+func removeLaunchTemplateVersionDescriptionMarker(description string) string {
+	return strings.TrimSpace(strings.TrimSuffix(description, launchTemplateVersionDescriptionMarker))
+}
+
+// isLaunchTemplateVersionManagedByVersionResource returns whether the specified version was created by aws_launch_template_version.
+func isLaunchTemplateVersionManagedByVersionResource(ltv *ec2.LaunchTemplateVersion) bool {
+	return strings.HasSuffix(aws.StringValue(ltv.VersionDescription), launchTemplateVersionDescriptionMarker)
+}
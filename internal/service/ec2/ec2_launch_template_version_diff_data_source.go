@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource(name="Launch Template Version Diff")
+func newDataSourceLaunchTemplateVersionDiff(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceLaunchTemplateVersionDiff{}, nil
+}
+
+type dataSourceLaunchTemplateVersionDiff struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceLaunchTemplateVersionDiff) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_launch_template_version_diff"
+}
+
+func (d *dataSourceLaunchTemplateVersionDiff) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"base_version": schema.StringAttribute{
+				Required: true,
+			},
+			"id": framework.IDAttribute(),
+			"launch_template_id": schema.StringAttribute{
+				Required: true,
+			},
+			"target_version": schema.StringAttribute{
+				Required: true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"differences": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[launchTemplateVersionDifferenceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"base_value": schema.StringAttribute{
+							Computed: true,
+						},
+						"path": schema.StringAttribute{
+							Computed: true,
+						},
+						"target_value": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceLaunchTemplateVersionDiff) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceLaunchTemplateVersionDiffModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EC2Conn(ctx)
+
+	launchTemplateID := data.LaunchTemplateID.ValueString()
+	var values []map[string]string
+	for _, version := range []string{data.BaseVersion.ValueString(), data.TargetVersion.ValueString()} {
+		ltv, err := FindLaunchTemplateVersionByTwoPartKey(ctx, conn, launchTemplateID, version)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Launch Template (%s) Version (%s)", launchTemplateID, version), err.Error())
+
+			return
+		}
+
+		v, err := flattenLaunchTemplateDataPaths(ltv.LaunchTemplateData)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading EC2 Launch Template (%s) Version (%s)", launchTemplateID, version), err.Error())
+
+			return
+		}
+
+		values = append(values, v)
+	}
+
+	var differences []*launchTemplateVersionDifferenceModel
+	for _, v := range launchTemplateDataDiff(values[0], values[1]) {
+		differences = append(differences, &launchTemplateVersionDifferenceModel{
+			BaseValue:   types.StringPointerValue(v.baseValue),
+			Path:        types.StringValue(v.path),
+			TargetValue: types.StringPointerValue(v.targetValue),
+		})
+	}
+
+	data.Differences = fwtypes.NewListNestedObjectValueOfSlice(ctx, differences)
+	data.ID = types.StringValue(fmt.Sprintf("%s:%s:%s", launchTemplateID, data.BaseVersion.ValueString(), data.TargetVersion.ValueString()))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceLaunchTemplateVersionDiffModel struct {
+	BaseVersion      types.String                                                          `tfsdk:"base_version"`
+	Differences      fwtypes.ListNestedObjectValueOf[launchTemplateVersionDifferenceModel] `tfsdk:"differences"`
+	ID               types.String                                                          `tfsdk:"id"`
+	LaunchTemplateID types.String                                                          `tfsdk:"launch_template_id"`
+	TargetVersion    types.String                                                          `tfsdk:"target_version"`
+}
+
+type launchTemplateVersionDifferenceModel struct {
+	BaseValue   types.String `tfsdk:"base_value"`
+	Path        types.String `tfsdk:"path"`
+	TargetValue types.String `tfsdk:"target_value"`
+}
+
+type launchTemplateDataDifference struct {
+	path        string
+	baseValue   *string
+	targetValue *string
+}
+
+// launchTemplateDataDiff returns the differences between two flattened sets of launch template data, ordered by path.
+func launchTemplateDataDiff(base, target map[string]string) []launchTemplateDataDifference {
+	var paths []string
+	for k := range base {
+		paths = append(paths, k)
+	}
+	for k := range target {
+		if _, ok := base[k]; !ok {
+			paths = append(paths, k)
+		}
+	}
+	slices.Sort(paths)
+
+	var differences []launchTemplateDataDifference
+	for _, path := range paths {
+		b, bok := base[path]
+		t, tok := target[path]
+
+		if bok && tok && b == t {
+			continue
+		}
+
+		difference := launchTemplateDataDifference{path: path}
+		if bok {
+			difference.baseValue = &b
+		}
+		if tok {
+			difference.targetValue = &t
+		}
+		differences = append(differences, difference)
+	}
+
+	return differences
+}
+
+// flattenLaunchTemplateDataPaths flattens launch template data into a map of paths, such as "BlockDeviceMappings[0].Ebs.VolumeSize", to scalar values.
+// Unset values are omitted.
+func flattenLaunchTemplateDataPaths(apiObject *ec2.ResponseLaunchTemplateData) (map[string]string, error) {
+	b, err := json.Marshal(apiObject)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Preserve the textual representation of numbers.
+	decoder := json.NewDecoder(bytes.NewReader(b))
+	decoder.UseNumber()
+
+	var v any
+	if err := decoder.Decode(&v); err != nil {
+		return nil, err
+	}
+
+	m := make(map[string]string)
+	flattenJSONPaths("", v, m)
+
+	return m, nil
+}
+
+func flattenJSONPaths(path string, v any, m map[string]string) {
+	switch v := v.(type) {
+	case nil:
+	case map[string]any:
+		for k, v := range v {
+			if path == "" {
+				flattenJSONPaths(k, v, m)
+			} else {
+				flattenJSONPaths(path+"."+k, v, m)
+			}
+		}
+	case []any:
+		for i, v := range v {
+			flattenJSONPaths(fmt.Sprintf("%s[%d]", path, i), v, m)
+		}
+	case string:
+		m[path] = v
+	default:
+		m[path] = fmt.Sprint(v)
+	}
+}
@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccEC2LaunchTemplateVersionDiffDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_launch_template_version_diff.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionDiffDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "differences.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "differences.0.path", "InstanceType"),
+					resource.TestCheckResourceAttr(dataSourceName, "differences.0.base_value", "t2.micro"),
+					resource.TestCheckResourceAttr(dataSourceName, "differences.0.target_value", "t2.small"),
+					resource.TestCheckResourceAttr(dataSourceName, "differences.1.path", "KeyName"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "differences.1.base_value"),
+					resource.TestCheckResourceAttr(dataSourceName, "differences.1.target_value", rName),
+				),
+			},
+		},
+	})
+}
+
+func testAccLaunchTemplateVersionDiffDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = aws_launch_template.test.latest_version
+
+  launch_template_data = jsonencode({
+    InstanceType = "t2.small"
+    KeyName      = %[1]q
+  })
+}
+
+data "aws_launch_template_version_diff" "test" {
+  launch_template_id = aws_launch_template.test.id
+  base_version       = aws_launch_template.test.latest_version
+  target_version     = aws_launch_template_version.test.version_number
+}
+`, rName)
+}
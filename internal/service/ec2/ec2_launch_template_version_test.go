@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfec2 "github.com/hashicorp/terraform-provider-aws/internal/service/ec2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestAccEC2LaunchTemplateVersion_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	launchTemplateResourceName := "aws_launch_template.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, "create_time"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_by"),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "false"),
+					resource.TestCheckResourceAttrPair(resourceName, "launch_template_id", launchTemplateResourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "source_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "version_description", rName),
+					resource.TestCheckResourceAttr(resourceName, "version_number", "2"),
+					func(s *terraform.State) error {
+						// The source version's instance type is inherited.
+						if got, want := aws.StringValue(v.LaunchTemplateData.InstanceType), "t2.micro"; got != want {
+							return fmt.Errorf("instance type = %q, want %q", got, want)
+						}
+						if got, want := aws.StringValue(v.LaunchTemplateData.KeyName), rName; got != want {
+							return fmt.Errorf("key name = %q, want %q", got, want)
+						}
+						// The version is marked as managed by aws_launch_template_version.
+						if got, want := aws.StringValue(v.VersionDescription), rName+" [terraform:aws_launch_template_version]"; got != want {
+							return fmt.Errorf("version description = %q, want %q", got, want)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfec2.ResourceLaunchTemplateVersion(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_defaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v ec2.LaunchTemplateVersion
+	resourceName := "aws_launch_template_version.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLaunchTemplateVersionConfig_defaultVersion(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLaunchTemplateVersionExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "default_version", "true"),
+					func(s *terraform.State) error {
+						if !aws.BoolValue(v.DefaultVersion) {
+							return fmt.Errorf("EC2 Launch Template Version (%d) is not the default version", aws.Int64Value(v.VersionNumber))
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestAccEC2LaunchTemplateVersion_unknownLaunchTemplateDataField(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckLaunchTemplateVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccLaunchTemplateVersionConfig_unknownLaunchTemplateDataField(rName),
+				ExpectError: regexache.MustCompile(`unknown field "InstanceTyp"`),
+			},
+		},
+	})
+}
+
+func testAccCheckLaunchTemplateVersionExists(ctx context.Context, n string, v *ec2.LaunchTemplateVersion) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		output, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, rs.Primary.Attributes["launch_template_id"], rs.Primary.Attributes["version_number"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLaunchTemplateVersionDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EC2Conn(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_launch_template_version" {
+				continue
+			}
+
+			parts := strings.Split(rs.Primary.ID, ",")
+			_, err := tfec2.FindLaunchTemplateVersionByTwoPartKey(ctx, conn, parts[0], parts[1])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("EC2 Launch Template Version %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLaunchTemplateVersionConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id  = aws_launch_template.test.id
+  source_version      = aws_launch_template.test.default_version
+  version_description = %[1]q
+
+  launch_template_data = jsonencode({
+    KeyName = %[1]q
+  })
+}
+`, rName)
+}
+
+func testAccLaunchTemplateVersionConfig_defaultVersion(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+  source_version     = "$Default"
+  default_version    = true
+
+  launch_template_data = jsonencode({
+    InstanceType = "t2.small"
+  })
+}
+`, rName)
+}
+
+func testAccLaunchTemplateVersionConfig_unknownLaunchTemplateDataField(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_launch_template" "test" {
+  name          = %[1]q
+  instance_type = "t2.micro"
+}
+
+resource "aws_launch_template_version" "test" {
+  launch_template_id = aws_launch_template.test.id
+
+  launch_template_data = jsonencode({
+    InstanceTyp = "t2.small"
+  })
+}
+`, rName)
+}
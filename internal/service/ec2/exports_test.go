@@ -6,6 +6,7 @@ package ec2
 // Exports for use in tests only.
 var (
 	ResourceInstanceConnectEndpoint  = newResourceInstanceConnectEndpoint
+	ResourceLaunchTemplateVersion    = resourceLaunchTemplateVersion
 	ResourceSecurityGroupEgressRule  = newResourceSecurityGroupEgressRule
 	ResourceSecurityGroupIngressRule = newResourceSecurityGroupIngressRule
 
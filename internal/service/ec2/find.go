@@ -162,6 +162,53 @@ func FindCapacityReservationByID(ctx context.Context, conn *ec2.EC2, id string)
 	return output, nil
 }
 
+// findCapacityBlockReservationByID returns the Capacity Reservation with the specified ID.
+// Unlike FindCapacityReservationByID, expired and cancelled reservations are returned as Capacity Blocks end rather than being deleted.
+func findCapacityBlockReservationByID(ctx context.Context, conn *ec2.EC2, id string) (*ec2.CapacityReservation, error) {
+	input := &ec2.DescribeCapacityReservationsInput{
+		CapacityReservationIds: aws.StringSlice([]string{id}),
+	}
+
+	output, err := FindCapacityReservation(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	// Eventual consistency check.
+	if aws.StringValue(output.CapacityReservationId) != id {
+		return nil, &retry.NotFoundError{
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func findCapacityBlockOfferings(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCapacityBlockOfferingsInput) ([]*ec2.CapacityBlockOffering, error) {
+	var output []*ec2.CapacityBlockOffering
+
+	err := conn.DescribeCapacityBlockOfferingsPagesWithContext(ctx, input, func(page *ec2.DescribeCapacityBlockOfferingsOutput, lastPage bool) bool {
+		if page == nil {
+			return !lastPage
+		}
+
+		for _, v := range page.CapacityBlockOfferings {
+			if v != nil {
+				output = append(output, v)
+			}
+		}
+
+		return !lastPage
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return output, nil
+}
+
 func FindCarrierGateway(ctx context.Context, conn *ec2.EC2, input *ec2.DescribeCarrierGatewaysInput) (*ec2.CarrierGateway, error) {
 	output, err := FindCarrierGateways(ctx, conn, input)
 
@@ -19,17 +19,36 @@ type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
 	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newDataSourceCapacityBlockOffering,
+			Name:    "Capacity Block Offering",
+		},
+		{
+			Factory: newDataSourceLaunchTemplateVersionDiff,
+			Name:    "Launch Template Version Diff",
+		},
 		{
 			Factory: newDataSourceSecurityGroupRule,
 		},
 		{
 			Factory: newDataSourceSecurityGroupRules,
 		},
+		{
+			Factory: newDataSourceSubnetPlan,
+			Name:    "Subnet Plan",
+		},
 	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceCapacityBlockReservation,
+			Name:    "Capacity Block Reservation",
+			Tags: &types.ServicePackageResourceTags{
+				IdentifierAttribute: "id",
+			},
+		},
 		{
 			Factory: newResourceInstanceConnectEndpoint,
 			Name:    "Instance Connect Endpoint",
@@ -684,6 +703,16 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTableAssociation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_association",
+			Name:     "Transit Gateway Default Route Table Association",
+		},
+		{
+			Factory:  resourceTransitGatewayDefaultRouteTablePropagation,
+			TypeName: "aws_ec2_transit_gateway_default_route_table_propagation",
+			Name:     "Transit Gateway Default Route Table Propagation",
+		},
 		{
 			Factory:  ResourceTransitGatewayMulticastDomain,
 			TypeName: "aws_ec2_transit_gateway_multicast_domain",
@@ -836,6 +865,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: "id",
 			},
 		},
+		{
+			Factory:  resourceLaunchTemplateVersion,
+			TypeName: "aws_launch_template_version",
+			Name:     "Launch Template Version",
+		},
 		{
 			Factory:  ResourceMainRouteTableAssociation,
 			TypeName: "aws_main_route_table_association",
@@ -1146,6 +1180,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceVPCPeeringConnectionOptions,
 			TypeName: "aws_vpc_peering_connection_options",
 		},
+		{
+			Factory:  resourceSecurityGroupRulesExclusive,
+			TypeName: "aws_vpc_security_group_rules_exclusive",
+			Name:     "Security Group Rules Exclusive",
+		},
 		{
 			Factory:  ResourceVPNConnection,
 			TypeName: "aws_vpn_connection",
@@ -5,6 +5,7 @@ package ec2
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"time"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
@@ -79,13 +81,13 @@ func ResourceTransitGateway() *schema.Resource {
 			"default_route_table_association": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      ec2.DefaultRouteTableAssociationValueEnable,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(ec2.DefaultRouteTableAssociationValue_Values(), false),
 			},
 			"default_route_table_propagation": {
 				Type:         schema.TypeString,
 				Optional:     true,
-				Default:      ec2.DefaultRouteTablePropagationValueEnable,
+				Computed:     true,
 				ValidateFunc: validation.StringInSlice(ec2.DefaultRouteTablePropagationValue_Values(), false),
 			},
 			"description": {
@@ -146,12 +148,10 @@ func resourceTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, m
 
 	input := &ec2.CreateTransitGatewayInput{
 		Options: &ec2.TransitGatewayRequestOptions{
-			AutoAcceptSharedAttachments:  aws.String(d.Get("auto_accept_shared_attachments").(string)),
-			DefaultRouteTableAssociation: aws.String(d.Get("default_route_table_association").(string)),
-			DefaultRouteTablePropagation: aws.String(d.Get("default_route_table_propagation").(string)),
-			DnsSupport:                   aws.String(d.Get("dns_support").(string)),
-			MulticastSupport:             aws.String(d.Get("multicast_support").(string)),
-			VpnEcmpSupport:               aws.String(d.Get("vpn_ecmp_support").(string)),
+			AutoAcceptSharedAttachments: aws.String(d.Get("auto_accept_shared_attachments").(string)),
+			DnsSupport:                  aws.String(d.Get("dns_support").(string)),
+			MulticastSupport:            aws.String(d.Get("multicast_support").(string)),
+			VpnEcmpSupport:              aws.String(d.Get("vpn_ecmp_support").(string)),
 		},
 		TagSpecifications: getTagSpecificationsIn(ctx, ec2.ResourceTypeTransitGateway),
 	}
@@ -160,6 +160,14 @@ func resourceTransitGatewayCreate(ctx context.Context, d *schema.ResourceData, m
 		input.Options.AmazonSideAsn = aws.Int64(int64(v.(int)))
 	}
 
+	if v, ok := d.GetOk("default_route_table_association"); ok {
+		input.Options.DefaultRouteTableAssociation = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("default_route_table_propagation"); ok {
+		input.Options.DefaultRouteTablePropagation = aws.String(v.(string))
+	}
+
 	if v, ok := d.GetOk("description"); ok {
 		input.Description = aws.String(v.(string))
 	}
@@ -307,3 +315,30 @@ func resourceTransitGatewayDelete(ctx context.Context, d *schema.ResourceData, m
 
 	return diags
 }
+
+// modifyTransitGatewayOptions modifies the specified transit gateway's options and waits for the modification to complete.
+func modifyTransitGatewayOptions(ctx context.Context, conn *ec2.EC2, id string, options *ec2.ModifyTransitGatewayOptions, timeout time.Duration) error {
+	input := &ec2.ModifyTransitGatewayInput{
+		Options:          options,
+		TransitGatewayId: aws.String(id),
+	}
+
+	_, err := conn.ModifyTransitGatewayWithContext(ctx, input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeInvalidTransitGatewayIDNotFound) {
+		return &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if _, err := WaitTransitGatewayUpdated(ctx, conn, id, timeout); err != nil {
+		return fmt.Errorf("waiting for update: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// transitGatewayDefaultRouteTableOption is one of a transit gateway's default route table options,
+// either default route table association or default route table propagation.
+type transitGatewayDefaultRouteTableOption struct {
+	// resourceName is the name of the resource that manages the option, used in messages.
+	resourceName string
+	// defaultRouteTableID returns the ID of the transit gateway's default route table, or "" if the option is disabled.
+	defaultRouteTableID func(*ec2.TransitGateway) string
+	// setDefaultRouteTableID sets the default route table in the specified options, or disables the option if id is "".
+	setDefaultRouteTableID func(options *ec2.ModifyTransitGatewayOptions, id string)
+}
+
+var (
+	transitGatewayDefaultRouteTableAssociationOption = transitGatewayDefaultRouteTableOption{
+		resourceName: "EC2 Transit Gateway Default Route Table Association",
+		defaultRouteTableID: func(transitGateway *ec2.TransitGateway) string {
+			if transitGateway.Options == nil || aws.StringValue(transitGateway.Options.DefaultRouteTableAssociation) != ec2.DefaultRouteTableAssociationValueEnable {
+				return ""
+			}
+
+			return aws.StringValue(transitGateway.Options.AssociationDefaultRouteTableId)
+		},
+		setDefaultRouteTableID: func(options *ec2.ModifyTransitGatewayOptions, id string) {
+			if id == "" {
+				options.DefaultRouteTableAssociation = aws.String(ec2.DefaultRouteTableAssociationValueDisable)
+				return
+			}
+
+			options.AssociationDefaultRouteTableId = aws.String(id)
+			options.DefaultRouteTableAssociation = aws.String(ec2.DefaultRouteTableAssociationValueEnable)
+		},
+	}
+
+	transitGatewayDefaultRouteTablePropagationOption = transitGatewayDefaultRouteTableOption{
+		resourceName: "EC2 Transit Gateway Default Route Table Propagation",
+		defaultRouteTableID: func(transitGateway *ec2.TransitGateway) string {
+			if transitGateway.Options == nil || aws.StringValue(transitGateway.Options.DefaultRouteTablePropagation) != ec2.DefaultRouteTablePropagationValueEnable {
+				return ""
+			}
+
+			return aws.StringValue(transitGateway.Options.PropagationDefaultRouteTableId)
+		},
+		setDefaultRouteTableID: func(options *ec2.ModifyTransitGatewayOptions, id string) {
+			if id == "" {
+				options.DefaultRouteTablePropagation = aws.String(ec2.DefaultRouteTablePropagationValueDisable)
+				return
+			}
+
+			options.PropagationDefaultRouteTableId = aws.String(id)
+			options.DefaultRouteTablePropagation = aws.String(ec2.DefaultRouteTablePropagationValueEnable)
+		},
+	}
+)
+
+// resource returns a resource that sets the transit gateway's default route table for the option
+// and restores the original default route table on destroy.
+func (o transitGatewayDefaultRouteTableOption) resource() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: o.create,
+		ReadWithoutTimeout:   o.read,
+		UpdateWithoutTimeout: o.update,
+		DeleteWithoutTimeout: o.delete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: o.importState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"original_default_route_table_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"transit_gateway_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			"transit_gateway_route_table_id": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+		},
+	}
+}
+
+func (o transitGatewayDefaultRouteTableOption) create(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGatewayID := d.Get("transit_gateway_id").(string)
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, transitGatewayID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Transit Gateway (%s): %s", transitGatewayID, err)
+	}
+
+	options := &ec2.ModifyTransitGatewayOptions{}
+	o.setDefaultRouteTableID(options, d.Get("transit_gateway_route_table_id").(string))
+
+	if err := modifyTransitGatewayOptions(ctx, conn, transitGatewayID, options, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating %s (%s): %s", o.resourceName, transitGatewayID, err)
+	}
+
+	d.SetId(transitGatewayID)
+	d.Set("original_default_route_table_id", o.defaultRouteTableID(transitGateway))
+
+	return append(diags, o.read(ctx, d, meta)...)
+}
+
+func (o transitGatewayDefaultRouteTableOption) read(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] %s (%s) not found, removing from state", o.resourceName, d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading %s (%s): %s", o.resourceName, d.Id(), err)
+	}
+
+	d.Set("transit_gateway_id", transitGateway.TransitGatewayId)
+	d.Set("transit_gateway_route_table_id", o.defaultRouteTableID(transitGateway))
+
+	return diags
+}
+
+func (o transitGatewayDefaultRouteTableOption) update(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	options := &ec2.ModifyTransitGatewayOptions{}
+	o.setDefaultRouteTableID(options, d.Get("transit_gateway_route_table_id").(string))
+
+	if err := modifyTransitGatewayOptions(ctx, conn, d.Id(), options, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating %s (%s): %s", o.resourceName, d.Id(), err)
+	}
+
+	return append(diags, o.read(ctx, d, meta)...)
+}
+
+func (o transitGatewayDefaultRouteTableOption) delete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	// Restore the original default route table, disabling the option if there was none.
+	options := &ec2.ModifyTransitGatewayOptions{}
+	o.setDefaultRouteTableID(options, d.Get("original_default_route_table_id").(string))
+
+	log.Printf("[DEBUG] Deleting %s: %s", o.resourceName, d.Id())
+	err := modifyTransitGatewayOptions(ctx, conn, d.Id(), options, d.Timeout(schema.TimeoutDelete))
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting %s (%s): %s", o.resourceName, d.Id(), err)
+	}
+
+	return diags
+}
+
+func (o transitGatewayDefaultRouteTableOption) importState(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	transitGateway, err := FindTransitGatewayByID(ctx, conn, d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	// The default route table at import time is restored on destroy.
+	d.Set("original_default_route_table_id", o.defaultRouteTableID(transitGateway))
+
+	return []*schema.ResourceData{d}, nil
+}
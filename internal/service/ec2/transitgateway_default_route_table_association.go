@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// @SDKResource("aws_ec2_transit_gateway_default_route_table_association", name="Transit Gateway Default Route Table Association")
+func resourceTransitGatewayDefaultRouteTableAssociation() *schema.Resource {
+	return transitGatewayDefaultRouteTableAssociationOption.resource()
+}
@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+)
+
+func testAccTransitGatewayDefaultRouteTableAssociation_basic(t *testing.T) {
+	testAccTransitGatewayDefaultRouteTable_basic(t, testAccTransitGatewayDefaultRouteTableAssociationOption)
+}
+
+func testAccTransitGatewayDefaultRouteTableAssociation_defaultDisabled(t *testing.T) {
+	testAccTransitGatewayDefaultRouteTable_defaultDisabled(t, testAccTransitGatewayDefaultRouteTableAssociationOption)
+}
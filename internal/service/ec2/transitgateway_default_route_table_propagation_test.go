@@ -0,0 +1,16 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+)
+
+func testAccTransitGatewayDefaultRouteTablePropagation_basic(t *testing.T) {
+	testAccTransitGatewayDefaultRouteTable_basic(t, testAccTransitGatewayDefaultRouteTablePropagationOption)
+}
+
+func testAccTransitGatewayDefaultRouteTablePropagation_defaultDisabled(t *testing.T) {
+	testAccTransitGatewayDefaultRouteTable_defaultDisabled(t, testAccTransitGatewayDefaultRouteTablePropagationOption)
+}
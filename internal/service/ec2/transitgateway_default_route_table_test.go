@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+// testAccTransitGatewayDefaultRouteTableOption describes the resource that manages one of a transit gateway's default route table options.
+type testAccTransitGatewayDefaultRouteTableOption struct {
+	// resourceType is the resource's type, e.g. "aws_ec2_transit_gateway_default_route_table_association".
+	resourceType string
+	// argument is the aws_ec2_transit_gateway argument that enables the option, e.g. "default_route_table_association".
+	argument string
+	// routeTableIDAttribute is the aws_ec2_transit_gateway attribute for the default route table, e.g. "association_default_route_table_id".
+	routeTableIDAttribute string
+	// routeTableID returns the default route table ID from the transit gateway's options.
+	routeTableID func(*ec2.TransitGatewayOptions) *string
+	// value returns the option's value ("enable" or "disable") from the transit gateway's options.
+	value func(*ec2.TransitGatewayOptions) *string
+}
+
+var (
+	testAccTransitGatewayDefaultRouteTableAssociationOption = testAccTransitGatewayDefaultRouteTableOption{
+		resourceType:          "aws_ec2_transit_gateway_default_route_table_association",
+		argument:              "default_route_table_association",
+		routeTableIDAttribute: "association_default_route_table_id",
+		routeTableID:          func(v *ec2.TransitGatewayOptions) *string { return v.AssociationDefaultRouteTableId },
+		value:                 func(v *ec2.TransitGatewayOptions) *string { return v.DefaultRouteTableAssociation },
+	}
+
+	testAccTransitGatewayDefaultRouteTablePropagationOption = testAccTransitGatewayDefaultRouteTableOption{
+		resourceType:          "aws_ec2_transit_gateway_default_route_table_propagation",
+		argument:              "default_route_table_propagation",
+		routeTableIDAttribute: "propagation_default_route_table_id",
+		routeTableID:          func(v *ec2.TransitGatewayOptions) *string { return v.PropagationDefaultRouteTableId },
+		value:                 func(v *ec2.TransitGatewayOptions) *string { return v.DefaultRouteTablePropagation },
+	}
+)
+
+func testAccTransitGatewayDefaultRouteTable_basic(t *testing.T, o testAccTransitGatewayDefaultRouteTableOption) {
+	ctx := acctest.Context(t)
+	var transitGateway ec2.TransitGateway
+	var originalRouteTableID string
+	resourceName := o.resourceType + ".test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	transitGatewayRouteTableResourceName := "aws_ec2_transit_gateway_route_table.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableConfig_basic(rName, o),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway),
+					resource.TestCheckResourceAttrPair(resourceName, "original_default_route_table_id", transitGatewayResourceName, o.routeTableIDAttribute),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_id", transitGatewayResourceName, "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "transit_gateway_route_table_id", transitGatewayRouteTableResourceName, "id"),
+					func(s *terraform.State) error {
+						originalRouteTableID = s.RootModule().Resources[resourceName].Primary.Attributes["original_default_route_table_id"]
+						return nil
+					},
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"original_default_route_table_id"},
+			},
+			{
+				// Destroying the resource restores the original default route table.
+				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway),
+					func(s *terraform.State) error {
+						if got, want := aws.StringValue(o.routeTableID(transitGateway.Options)), originalRouteTableID; got != want {
+							return fmt.Errorf("EC2 Transit Gateway %s = %q, want %q", o.routeTableIDAttribute, got, want)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTable_defaultDisabled(t *testing.T, o testAccTransitGatewayDefaultRouteTableOption) {
+	ctx := acctest.Context(t)
+	var transitGateway ec2.TransitGateway
+	resourceName := o.resourceType + ".test"
+	transitGatewayResourceName := "aws_ec2_transit_gateway.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckTransitGateway(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTransitGatewayDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTransitGatewayDefaultRouteTableConfig_transitGatewayDisabled(rName, o),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway),
+					resource.TestCheckResourceAttr(transitGatewayResourceName, o.argument, "disable"),
+				),
+			},
+			{
+				// Removing the transit gateway's argument and enabling the option via the new resource must not cause a perpetual diff.
+				Config: testAccTransitGatewayDefaultRouteTableConfig_basic(rName, o),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway),
+					resource.TestCheckResourceAttr(resourceName, "original_default_route_table_id", ""),
+				),
+			},
+			{
+				// Destroying the resource disables the option again.
+				Config: testAccTransitGatewayRouteTableConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTransitGatewayExists(ctx, transitGatewayResourceName, &transitGateway),
+					func(s *terraform.State) error {
+						if got, want := aws.StringValue(o.value(transitGateway.Options)), "disable"; got != want {
+							return fmt.Errorf("EC2 Transit Gateway %s = %q, want %q", o.argument, got, want)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccTransitGatewayDefaultRouteTableConfig_basic(rName string, o testAccTransitGatewayDefaultRouteTableOption) string {
+	return acctest.ConfigCompose(testAccTransitGatewayRouteTableConfig_basic(rName), fmt.Sprintf(`
+resource %[1]q "test" {
+  transit_gateway_id             = aws_ec2_transit_gateway.test.id
+  transit_gateway_route_table_id = aws_ec2_transit_gateway_route_table.test.id
+}
+`, o.resourceType))
+}
+
+func testAccTransitGatewayDefaultRouteTableConfig_transitGatewayDisabled(rName string, o testAccTransitGatewayDefaultRouteTableOption) string {
+	return fmt.Sprintf(`
+resource "aws_ec2_transit_gateway" "test" {
+  %[2]s = "disable"
+
+  tags = {
+    Name = %[1]q
+  }
+}
+
+resource "aws_ec2_transit_gateway_route_table" "test" {
+  transit_gateway_id = aws_ec2_transit_gateway.test.id
+}
+`, rName, o.argument)
+}
@@ -42,6 +42,14 @@ func TestAccTransitGateway_serial(t *testing.T) {
 			"InsideCidrBlocks":      testAccTransitGatewayConnectPeer_insideCIDRBlocks,
 			"TransitGatewayAddress": testAccTransitGatewayConnectPeer_TransitGatewayAddress,
 		},
+		"DefaultRouteTableAssociation": {
+			"basic":           testAccTransitGatewayDefaultRouteTableAssociation_basic,
+			"defaultDisabled": testAccTransitGatewayDefaultRouteTableAssociation_defaultDisabled,
+		},
+		"DefaultRouteTablePropagation": {
+			"basic":           testAccTransitGatewayDefaultRouteTablePropagation_basic,
+			"defaultDisabled": testAccTransitGatewayDefaultRouteTablePropagation_defaultDisabled,
+		},
 		"Gateway": {
 			"basic":                       testAccTransitGateway_basic,
 			"disappears":                  testAccTransitGateway_disappears,
@@ -86,7 +86,7 @@ func resourceDefaultVPCDHCPOptionsCreate(ctx context.Context, d *schema.Resource
 
 	input.Filters = append(input.Filters,
 		NewFilter("key", []string{"domain-name"}),
-		NewFilter("value", []string{RegionalPrivateDNSSuffix(meta.(*conns.AWSClient).Region)}),
+		NewFilter("value", []string{RegionalPrivateDNSSuffix(meta.(*conns.AWSClient).Region(ctx))}),
 		NewFilter("key", []string{"domain-name-servers"}),
 		NewFilter("value", []string{"AmazonProvidedDNS"}),
 	)
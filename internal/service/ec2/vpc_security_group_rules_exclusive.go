@@ -0,0 +1,145 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @SDKResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func resourceSecurityGroupRulesExclusive() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		ReadWithoutTimeout:   resourceSecurityGroupRulesExclusiveRead,
+		UpdateWithoutTimeout: resourceSecurityGroupRulesExclusivePut,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"egress_rule_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"ingress_rule_ids": {
+				Type:     schema.TypeSet,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"security_group_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceSecurityGroupRulesExclusivePut(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	securityGroupID := d.Get("security_group_id").(string)
+	egressRuleIDs := flex.ExpandStringValueSet(d.Get("egress_rule_ids").(*schema.Set))
+	ingressRuleIDs := flex.ExpandStringValueSet(d.Get("ingress_rule_ids").(*schema.Set))
+
+	haveEgress, haveIngress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, securityGroupID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group (%s) rules: %s", securityGroupID, err)
+	}
+
+	// Nothing is revoked if any configured rule is not one of the security group's rules in the same direction.
+	if ids := flex.Set[string](ingressRuleIDs).Difference(haveIngress); len(ids) > 0 {
+		return sdkdiag.AppendErrorf(diags, "VPC Security Group (%s) does not have ingress rules (%v)", securityGroupID, ids)
+	}
+
+	if ids := flex.Set[string](egressRuleIDs).Difference(haveEgress); len(ids) > 0 {
+		return sdkdiag.AppendErrorf(diags, "VPC Security Group (%s) does not have egress rules (%v)", securityGroupID, ids)
+	}
+
+	if ids := flex.Set[string](haveIngress).Difference(ingressRuleIDs); len(ids) > 0 {
+		input := &ec2.RevokeSecurityGroupIngressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(ids),
+		}
+
+		if _, err := conn.RevokeSecurityGroupIngressWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "revoking VPC Security Group (%s) ingress rules (%v): %s", securityGroupID, ids, err)
+		}
+	}
+
+	if ids := flex.Set[string](haveEgress).Difference(egressRuleIDs); len(ids) > 0 {
+		input := &ec2.RevokeSecurityGroupEgressInput{
+			GroupId:              aws.String(securityGroupID),
+			SecurityGroupRuleIds: aws.StringSlice(ids),
+		}
+
+		if _, err := conn.RevokeSecurityGroupEgressWithContext(ctx, input); err != nil {
+			return sdkdiag.AppendErrorf(diags, "revoking VPC Security Group (%s) egress rules (%v): %s", securityGroupID, ids, err)
+		}
+	}
+
+	d.SetId(securityGroupID)
+
+	return append(diags, resourceSecurityGroupRulesExclusiveRead(ctx, d, meta)...)
+}
+
+func resourceSecurityGroupRulesExclusiveRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EC2Conn(ctx)
+
+	if _, err := FindSecurityGroupByID(ctx, conn, d.Id()); !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] VPC Security Group Rules Exclusive (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	} else if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group Rules Exclusive (%s): %s", d.Id(), err)
+	}
+
+	egressRuleIDs, ingressRuleIDs, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading VPC Security Group Rules Exclusive (%s): %s", d.Id(), err)
+	}
+
+	d.Set("egress_rule_ids", egressRuleIDs)
+	d.Set("ingress_rule_ids", ingressRuleIDs)
+	d.Set("security_group_id", d.Id())
+
+	return diags
+}
+
+// findSecurityGroupRuleIDsBySecurityGroupID returns the IDs of the specified security group's egress and ingress rules.
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.EC2, id string) ([]string, []string, error) {
+	output, err := FindSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+
+	if err != nil {
+		return nil, nil, err
+	}
+
+	egressRuleIDs, ingressRuleIDs := []string{}, []string{}
+	for _, v := range output {
+		if aws.BoolValue(v.IsEgress) {
+			egressRuleIDs = append(egressRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
+		} else {
+			ingressRuleIDs = append(ingressRuleIDs, aws.StringValue(v.SecurityGroupRuleId))
+		}
+	}
+
+	return egressRuleIDs, ingressRuleIDs, nil
+}
@@ -0,0 +1,301 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	itypes "github.com/hashicorp/terraform-provider-aws/internal/types"
+)
+
+// @FrameworkDataSource(name="Subnet Plan")
+func newDataSourceSubnetPlan(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &dataSourceSubnetPlan{}, nil
+}
+
+type dataSourceSubnetPlan struct {
+	framework.DataSourceWithConfigure
+}
+
+func (d *dataSourceSubnetPlan) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) { // nosemgrep:ci.meta-in-func-name
+	response.TypeName = "aws_vpc_subnet_plan"
+}
+
+func (d *dataSourceSubnetPlan) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"availability_zone_slots": schema.Int64Attribute{
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"availability_zones": schema.ListAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+					listvalidator.UniqueValues(),
+				},
+			},
+			"cidr_block": schema.StringAttribute{
+				CustomType: fwtypes.CIDRBlockType,
+				Required:   true,
+			},
+			"id": framework.IDAttribute(),
+			"ipv6_cidr_block": schema.StringAttribute{
+				CustomType: fwtypes.CIDRBlockType,
+				Optional:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"subnets": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subnetPlanSubnetModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"availability_zone": schema.StringAttribute{
+							Computed: true,
+						},
+						"cidr_block": schema.StringAttribute{
+							Computed: true,
+						},
+						"ipv6_cidr_block": schema.StringAttribute{
+							Computed: true,
+						},
+						"tier": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"tier": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[subnetPlanTierModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required: true,
+						},
+						"prefix_length": schema.Int64Attribute{
+							Required: true,
+							Validators: []validator.Int64{
+								int64validator.Between(16, 28),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *dataSourceSubnetPlan) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data dataSourceSubnetPlanModel
+
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var availabilityZones []string
+	response.Diagnostics.Append(data.AvailabilityZones.ElementsAs(ctx, &availabilityZones, false)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tiers, diags := data.Tiers.ToSlice(ctx)
+	response.Diagnostics.Append(diags...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	slots := len(availabilityZones)
+	if !data.AvailabilityZoneSlots.IsNull() {
+		slots = int(data.AvailabilityZoneSlots.ValueInt64())
+	}
+
+	if slots < len(availabilityZones) {
+		response.Diagnostics.AddAttributeError(
+			path.Root("availability_zone_slots"),
+			"Invalid Attribute Value",
+			fmt.Sprintf("availability_zone_slots (%d) must be at least the number of availability_zones (%d)", slots, len(availabilityZones)),
+		)
+
+		return
+	}
+
+	plan := &subnetPlan{
+		availabilityZones: availabilityZones,
+		cidrBlock:         data.CIDRBlock.ValueString(),
+		ipv6CIDRBlock:     data.IPv6CIDRBlock.ValueString(),
+		slots:             slots,
+	}
+	for _, v := range tiers {
+		plan.tiers = append(plan.tiers, subnetPlanTier{
+			name:         v.Name.ValueString(),
+			prefixLength: int(v.PrefixLength.ValueInt64()),
+		})
+	}
+
+	subnets, err := plan.allocate()
+
+	if err != nil {
+		response.Diagnostics.AddError("planning VPC subnets", err.Error())
+
+		return
+	}
+
+	var models []*subnetPlanSubnetModel
+	for _, v := range subnets {
+		model := &subnetPlanSubnetModel{
+			AvailabilityZone: types.StringValue(v.availabilityZone),
+			CIDRBlock:        types.StringValue(v.cidrBlock),
+			IPv6CIDRBlock:    types.StringNull(),
+			Tier:             types.StringValue(v.tier),
+		}
+		if v.ipv6CIDRBlock != "" {
+			model.IPv6CIDRBlock = types.StringValue(v.ipv6CIDRBlock)
+		}
+		models = append(models, model)
+	}
+
+	data.ID = types.StringValue(data.CIDRBlock.ValueString())
+	data.Subnets = fwtypes.NewListNestedObjectValueOfSlice(ctx, models)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type dataSourceSubnetPlanModel struct {
+	AvailabilityZones     types.List                                             `tfsdk:"availability_zones"`
+	AvailabilityZoneSlots types.Int64                                            `tfsdk:"availability_zone_slots"`
+	CIDRBlock             fwtypes.CIDRBlock                                      `tfsdk:"cidr_block"`
+	ID                    types.String                                           `tfsdk:"id"`
+	IPv6CIDRBlock         fwtypes.CIDRBlock                                      `tfsdk:"ipv6_cidr_block"`
+	Subnets               fwtypes.ListNestedObjectValueOf[subnetPlanSubnetModel] `tfsdk:"subnets"`
+	Tiers                 fwtypes.ListNestedObjectValueOf[subnetPlanTierModel]   `tfsdk:"tier"`
+}
+
+type subnetPlanSubnetModel struct {
+	AvailabilityZone types.String `tfsdk:"availability_zone"`
+	CIDRBlock        types.String `tfsdk:"cidr_block"`
+	IPv6CIDRBlock    types.String `tfsdk:"ipv6_cidr_block"`
+	Tier             types.String `tfsdk:"tier"`
+}
+
+type subnetPlanTierModel struct {
+	Name         types.String `tfsdk:"name"`
+	PrefixLength types.Int64  `tfsdk:"prefix_length"`
+}
+
+// subnetPlan lays out the subnets of a VPC.
+// Tiers are allocated in order, each reserving one subnet per availability zone slot.
+// Within the VPC's IPv4 CIDR block each subnet is allocated the lowest free block of its tier's size, so appending a tier,
+// or adding an availability zone for which a slot is already reserved, never changes any existing subnet's CIDR blocks.
+// The nth subnet in the plan (counting reserved slots) is allocated the nth /64 of the VPC's IPv6 CIDR block, if any.
+type subnetPlan struct {
+	availabilityZones []string
+	cidrBlock         string
+	ipv6CIDRBlock     string
+	slots             int
+	tiers             []subnetPlanTier
+}
+
+type subnetPlanTier struct {
+	name         string
+	prefixLength int
+}
+
+type subnetPlanSubnet struct {
+	availabilityZone string
+	cidrBlock        string
+	ipv6CIDRBlock    string
+	tier             string
+}
+
+func (p *subnetPlan) allocate() ([]subnetPlanSubnet, error) {
+	allocator, err := itypes.NewCIDRAllocator(p.cidrBlock)
+
+	if err != nil {
+		return nil, err
+	}
+
+	ipv6NewBits := 0
+	if p.ipv6CIDRBlock != "" {
+		_, ipnet, err := net.ParseCIDR(p.ipv6CIDRBlock)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if ipnet.IP.To4() != nil {
+			return nil, fmt.Errorf("%q is not an IPv6 CIDR block", p.ipv6CIDRBlock)
+		}
+
+		ones, _ := ipnet.Mask.Size()
+		if ones > 64 {
+			return nil, fmt.Errorf("IPv6 CIDR block (%s) prefix length must be at most /64", p.ipv6CIDRBlock)
+		}
+		ipv6NewBits = 64 - ones
+	}
+
+	tierNames := make(map[string]struct{})
+	var subnets []subnetPlanSubnet
+
+	for i, tier := range p.tiers {
+		if _, ok := tierNames[tier.name]; ok {
+			return nil, fmt.Errorf("duplicate tier name: %s", tier.name)
+		}
+		tierNames[tier.name] = struct{}{}
+
+		for j := 0; j < p.slots; j++ {
+			cidrBlock, err := allocator.Allocate(tier.prefixLength)
+
+			if err != nil {
+				return nil, fmt.Errorf("tier (%s): %w", tier.name, err)
+			}
+
+			// Only reserve the address space for unused slots.
+			if j >= len(p.availabilityZones) {
+				continue
+			}
+
+			subnet := subnetPlanSubnet{
+				availabilityZone: p.availabilityZones[j],
+				cidrBlock:        cidrBlock,
+				tier:             tier.name,
+			}
+
+			if p.ipv6CIDRBlock != "" {
+				ipv6CIDRBlock, err := itypes.CIDRSubnet(p.ipv6CIDRBlock, ipv6NewBits, int64(i*p.slots+j))
+
+				if err != nil {
+					return nil, fmt.Errorf("tier (%s) IPv6: %w", tier.name, err)
+				}
+
+				subnet.ipv6CIDRBlock = ipv6CIDRBlock
+			}
+
+			subnets = append(subnets, subnet)
+		}
+	}
+
+	return subnets, nil
+}
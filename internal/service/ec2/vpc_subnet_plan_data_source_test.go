@@ -0,0 +1,170 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2_test
+
+import (
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+)
+
+func TestAccVPCSubnetPlanDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_subnet_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.tier", "public"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.availability_zone", "zone-a"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.ipv6_cidr_block", "2600:1f14:abc:de00::/64"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.availability_zone", "zone-b"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.ipv6_cidr_block", "2600:1f14:abc:de01::/64"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.tier", "private"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.16.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.ipv6_cidr_block", "2600:1f14:abc:de02::/64"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.cidr_block", "10.0.32.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.ipv6_cidr_block", "2600:1f14:abc:de03::/64"),
+				),
+			},
+			{
+				// Appending a tier doesn't change existing subnets.
+				Config: testAccVPCSubnetPlanDataSourceConfig_appendTier,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "6"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.16.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.cidr_block", "10.0.32.0/20"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.4.tier", "database"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.4.cidr_block", "10.0.2.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.4.ipv6_cidr_block", "2600:1f14:abc:de04::/64"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.5.cidr_block", "10.0.3.0/24"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnetPlanDataSource_availabilityZoneSlots(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_vpc_subnet_plan.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSubnetPlanDataSourceConfig_availabilityZoneSlots,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.#", "4"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.0.cidr_block", "10.0.0.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.1.cidr_block", "10.0.1.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.2.cidr_block", "10.0.4.0/24"),
+					resource.TestCheckResourceAttr(dataSourceName, "subnets.3.cidr_block", "10.0.5.0/24"),
+					resource.TestCheckNoResourceAttr(dataSourceName, "subnets.0.ipv6_cidr_block"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSubnetPlanDataSource_insufficientSpace(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, ec2.EndpointsID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccVPCSubnetPlanDataSourceConfig_insufficientSpace,
+				ExpectError: regexache.MustCompile(`no unallocated /17 CIDR block remains`),
+			},
+		},
+	})
+}
+
+const testAccVPCSubnetPlanDataSourceConfig_basic = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block         = "10.0.0.0/16"
+  ipv6_cidr_block    = "2600:1f14:abc:de00::/56"
+  availability_zones = ["zone-a", "zone-b"]
+
+  tier {
+    name          = "public"
+    prefix_length = 24
+  }
+
+  tier {
+    name          = "private"
+    prefix_length = 20
+  }
+}
+`
+
+const testAccVPCSubnetPlanDataSourceConfig_appendTier = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block         = "10.0.0.0/16"
+  ipv6_cidr_block    = "2600:1f14:abc:de00::/56"
+  availability_zones = ["zone-a", "zone-b"]
+
+  tier {
+    name          = "public"
+    prefix_length = 24
+  }
+
+  tier {
+    name          = "private"
+    prefix_length = 20
+  }
+
+  tier {
+    name          = "database"
+    prefix_length = 24
+  }
+}
+`
+
+const testAccVPCSubnetPlanDataSourceConfig_availabilityZoneSlots = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block              = "10.0.0.0/16"
+  availability_zones      = ["zone-a", "zone-b"]
+  availability_zone_slots = 4
+
+  tier {
+    name          = "public"
+    prefix_length = 24
+  }
+
+  tier {
+    name          = "private"
+    prefix_length = 24
+  }
+}
+`
+
+const testAccVPCSubnetPlanDataSourceConfig_insufficientSpace = `
+data "aws_vpc_subnet_plan" "test" {
+  cidr_block         = "10.0.0.0/16"
+  availability_zones = ["zone-a", "zone-b", "zone-c"]
+
+  tier {
+    name          = "private"
+    prefix_length = 17
+  }
+}
+`
@@ -121,6 +121,23 @@ func WaitCapacityReservationDeleted(ctx context.Context, conn *ec2.EC2, id strin
 	return nil, err
 }
 
+func waitCapacityBlockReservationPurchased(ctx context.Context, conn *ec2.EC2, id string, timeout time.Duration) (*ec2.CapacityReservation, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{ec2.CapacityReservationStatePaymentPending},
+		Target:  []string{ec2.CapacityReservationStateScheduled, ec2.CapacityReservationStateActive},
+		Refresh: StatusCapacityReservationState(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*ec2.CapacityReservation); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
 const (
 	CarrierGatewayAvailableTimeout = 5 * time.Minute
 
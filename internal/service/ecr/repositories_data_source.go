@@ -64,7 +64,7 @@ func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		}
 	}
 
-	data.ID = flex.StringValueToFramework(ctx, d.Meta().Region)
+	data.ID = flex.StringValueToFramework(ctx, d.Meta().Region(ctx))
 	data.Names = flex.FlattenFrameworkStringValueSet(ctx, names)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
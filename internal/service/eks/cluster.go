@@ -690,11 +690,12 @@ func statusClusterUpdate(ctx context.Context, conn *eks.Client, name, id string)
 }
 
 func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
-		Target:  enum.Slice(types.ClusterStatusActive),
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "creating",
+		Pending:   enum.Slice(types.ClusterStatusPending, types.ClusterStatusCreating),
+		Target:    enum.Slice(types.ClusterStatusActive),
+		Refresh:   statusCluster(ctx, conn, name),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -707,11 +708,12 @@ func waitClusterCreated(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, timeout time.Duration) (*types.Cluster, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
-		Target:  []string{},
-		Refresh: statusCluster(ctx, conn, name),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "deleting",
+		Pending:   enum.Slice(types.ClusterStatusActive, types.ClusterStatusDeleting),
+		Target:    []string{},
+		Refresh:   statusCluster(ctx, conn, name),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -724,11 +726,12 @@ func waitClusterDeleted(ctx context.Context, conn *eks.Client, name string, time
 }
 
 func waitClusterUpdateSuccessful(ctx context.Context, conn *eks.Client, name, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.UpdateStatusInProgress),
-		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusClusterUpdate(ctx, conn, name, id),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "updating",
+		Pending:   enum.Slice(types.UpdateStatusInProgress),
+		Target:    enum.Slice(types.UpdateStatusSuccessful),
+		Refresh:   statusClusterUpdate(ctx, conn, name, id),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
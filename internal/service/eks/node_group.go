@@ -665,11 +665,12 @@ func statusNodegroupUpdate(ctx context.Context, conn *eks.Client, clusterName, n
 }
 
 func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.NodegroupStatusCreating),
-		Target:  enum.Slice(types.NodegroupStatusActive),
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "creating",
+		Pending:   enum.Slice(types.NodegroupStatusCreating),
+		Target:    enum.Slice(types.NodegroupStatusActive),
+		Refresh:   statusNodegroup(ctx, conn, clusterName, nodeGroupName),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -686,11 +687,12 @@ func waitNodegroupCreated(ctx context.Context, conn *eks.Client, clusterName, no
 }
 
 func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName string, timeout time.Duration) (*types.Nodegroup, error) {
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.NodegroupStatusActive, types.NodegroupStatusDeleting),
-		Target:  []string{},
-		Refresh: statusNodegroup(ctx, conn, clusterName, nodeGroupName),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "deleting",
+		Pending:   enum.Slice(types.NodegroupStatusActive, types.NodegroupStatusDeleting),
+		Target:    []string{},
+		Refresh:   statusNodegroup(ctx, conn, clusterName, nodeGroupName),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -707,11 +709,12 @@ func waitNodegroupDeleted(ctx context.Context, conn *eks.Client, clusterName, no
 }
 
 func waitNodegroupUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterName, nodeGroupName, id string, timeout time.Duration) (*types.Update, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
-		Pending: enum.Slice(types.UpdateStatusInProgress),
-		Target:  enum.Slice(types.UpdateStatusSuccessful),
-		Refresh: statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
-		Timeout: timeout,
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "updating",
+		Pending:   enum.Slice(types.UpdateStatusInProgress),
+		Target:    enum.Slice(types.UpdateStatusSuccessful),
+		Refresh:   statusNodegroupUpdate(ctx, conn, clusterName, nodeGroupName, id),
+		Timeout:   timeout,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -17,5 +17,11 @@ var (
 	FindAttachedUserPolicies            = findAttachedUserPolicies
 	FindAttachedUserPolicyByTwoPartKey  = findAttachedUserPolicyByTwoPartKey
 	FindEntitiesForPolicyByARN          = findEntitiesForPolicyByARN
+	FindGroupAttachedPolicyARNs         = findGroupAttachedPolicyARNs
+	FindGroupPolicyNames                = findGroupPolicyNames
 	FindPolicyByARN                     = findPolicyByARN
+	FindRoleAttachedPolicies            = findRoleAttachedPolicies
+	FindRolePolicyNames                 = findRolePolicyNames
+	FindUserAttachedPolicyARNs          = findUserAttachedPolicyARNs
+	FindUserPolicyNames                 = findUserPolicyNames
 )
@@ -118,6 +118,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceGroupMembership,
 			TypeName: "aws_iam_group_membership",
 		},
+		{
+			Factory:  resourceGroupPoliciesExclusive,
+			TypeName: "aws_iam_group_policies_exclusive",
+			Name:     "Group Policies Exclusive",
+		},
 		{
 			Factory:  ResourceGroupPolicy,
 			TypeName: "aws_iam_group_policy",
@@ -127,6 +132,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_group_policy_attachment",
 			Name:     "Group Policy Attachment",
 		},
+		{
+			Factory:  resourceGroupPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_group_policy_attachments_exclusive",
+			Name:     "Group Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceInstanceProfile,
 			TypeName: "aws_iam_instance_profile",
@@ -156,6 +166,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Name:     "Role",
 			Tags:     &types.ServicePackageResourceTags{},
 		},
+		{
+			Factory:  resourceRolePoliciesExclusive,
+			TypeName: "aws_iam_role_policies_exclusive",
+			Name:     "Role Policies Exclusive",
+		},
 		{
 			Factory:  ResourceRolePolicy,
 			TypeName: "aws_iam_role_policy",
@@ -165,6 +180,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_role_policy_attachment",
 			Name:     "Role Policy Attachment",
 		},
+		{
+			Factory:  resourceRolePolicyAttachmentsExclusive,
+			TypeName: "aws_iam_role_policy_attachments_exclusive",
+			Name:     "Role Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceSAMLProvider,
 			TypeName: "aws_iam_saml_provider",
@@ -210,6 +230,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Factory:  ResourceUserLoginProfile,
 			TypeName: "aws_iam_user_login_profile",
 		},
+		{
+			Factory:  resourceUserPoliciesExclusive,
+			TypeName: "aws_iam_user_policies_exclusive",
+			Name:     "User Policies Exclusive",
+		},
 		{
 			Factory:  ResourceUserPolicy,
 			TypeName: "aws_iam_user_policy",
@@ -219,6 +244,11 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			TypeName: "aws_iam_user_policy_attachment",
 			Name:     "User Policy Attachment",
 		},
+		{
+			Factory:  resourceUserPolicyAttachmentsExclusive,
+			TypeName: "aws_iam_user_policy_attachments_exclusive",
+			Name:     "User Policy Attachments Exclusive",
+		},
 		{
 			Factory:  ResourceUserSSHKey,
 			TypeName: "aws_iam_user_ssh_key",
@@ -138,7 +138,7 @@ func resourceAliasRead(ctx context.Context, d *schema.ResourceData, meta interfa
 	d.Set("arn", aliasConfiguration.AliasArn)
 	d.SetId(aws.StringValue(aliasConfiguration.AliasArn))
 
-	invokeArn := functionInvokeARN(*aliasConfiguration.AliasArn, meta)
+	invokeArn := functionInvokeARN(ctx, *aliasConfiguration.AliasArn, meta)
 	d.Set("invoke_arn", invokeArn)
 
 	if err := d.Set("routing_config", flattenAliasRoutingConfiguration(aliasConfiguration.RoutingConfig)); err != nil {
@@ -68,7 +68,7 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 		return create.DiagError(names.Lambda, create.ErrActionReading, DSNameFunctions, "", err)
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("function_arns", functionARNs)
 	d.Set("function_names", functionNames)
 
@@ -0,0 +1,14 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+// Exports for use in tests only.
+var (
+	ResourceTag = resourceTag
+
+	ContextWithRegion        = contextWithRegion
+	FindTagByARN             = findTagByARN
+	ServicePackageNameForARN = servicePackageNameForARN
+	TaggingIdentifier        = taggingIdentifier
+)
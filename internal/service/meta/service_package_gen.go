@@ -50,7 +50,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceTag,
+			TypeName: "aws_tag",
+			Name:     "Tag",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
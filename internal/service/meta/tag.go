@@ -0,0 +1,323 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_tag", name="Tag")
+func resourceTag() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagCreate,
+		ReadWithoutTimeout:   resourceTagRead,
+		UpdateWithoutTimeout: resourceTagUpdate,
+		DeleteWithoutTimeout: resourceTagDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"resource_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"value": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func resourceTagCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics { // nosemgrep:ci.semgrep.tags.calling-UpdateTags-in-resource-create
+	var diags diag.Diagnostics
+
+	resourceARN := d.Get("resource_arn").(string)
+	key := d.Get("key").(string)
+	value := d.Get("value").(string)
+
+	ctx, sp, identifier, err := findTaggerByARN(ctx, meta.(*conns.AWSClient), resourceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	if err := sp.UpdateTags(ctx, meta, identifier, nil, map[string]string{key: value}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	d.SetId(tftags.SetResourceID(resourceARN, key))
+
+	return append(diags, resourceTagRead(ctx, d, meta)...)
+}
+
+func resourceTagRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceARN, key, err := tftags.GetResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	value, err := findTagByARN(ctx, meta.(*conns.AWSClient), resourceARN, key)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Resource (%s) tag (%s) not found, removing from state", resourceARN, key)
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	d.Set("key", key)
+	d.Set("resource_arn", resourceARN)
+	d.Set("value", value)
+
+	return diags
+}
+
+func resourceTagUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceARN, key, err := tftags.GetResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx, sp, identifier, err := findTaggerByARN(ctx, meta.(*conns.AWSClient), resourceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	if err := sp.UpdateTags(ctx, meta, identifier, nil, map[string]string{key: d.Get("value").(string)}); err != nil {
+		return sdkdiag.AppendErrorf(diags, "updating resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	return append(diags, resourceTagRead(ctx, d, meta)...)
+}
+
+func resourceTagDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	resourceARN, key, err := tftags.GetResourceID(d.Id())
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	ctx, sp, identifier, err := findTaggerByARN(ctx, meta.(*conns.AWSClient), resourceARN)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	if err := sp.UpdateTags(ctx, meta, identifier, map[string]string{key: d.Get("value").(string)}, nil); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting resource (%s) tag (%s): %s", resourceARN, key, err)
+	}
+
+	return diags
+}
+
+// tagger is implemented by service packages with generic resource tagging methods.
+type tagger interface {
+	// ListTags lists the tags of the specified resource and sets them in Context.
+	ListTags(context.Context, any, string) error
+	// UpdateTags updates the tags of the specified resource.
+	UpdateTags(context.Context, any, string, any, any) error
+}
+
+var (
+	// arnServicePackageNames maps ARN service namespaces to service package names where they differ.
+	arnServicePackageNames = map[string]string{
+		"elasticfilesystem":    names.EFS,
+		"elasticloadbalancing": names.ELBV2,
+		"es":                   names.OpenSearch,
+		"execute-api":          names.APIGateway,
+		"states":               names.SFN,
+	}
+)
+
+// servicePackageNameForARN returns the name of the service package that manages the resource with the specified ARN.
+func servicePackageNameForARN(arn arn.ARN) (string, error) {
+	switch arn.Service {
+	case "elasticloadbalancing":
+		// Classic Load Balancer ARNs have no load balancer type.
+		if strings.HasPrefix(arn.Resource, "loadbalancer/") && strings.Count(arn.Resource, "/") == 1 {
+			return names.ELB, nil
+		}
+	}
+
+	if v, ok := arnServicePackageNames[arn.Service]; ok {
+		return v, nil
+	}
+
+	return names.ProviderPackageForAlias(arn.Service)
+}
+
+// taggingIdentifier returns the identifier that a service package's generic resource tagging methods use for the resource with the specified ARN.
+// Most services tag resources by ARN. Services whose tagging APIs take a different identifier that can't be derived from the ARN are rejected.
+func taggingIdentifier(servicePackageName string, arn arn.ARN) (string, error) {
+	switch servicePackageName {
+	// Resources are tagged by the ID or name that ends the ARN's resource,
+	// e.g. "vpc-0123456789abcdef0" from "vpc/vpc-0123456789abcdef0".
+	case names.CloudHSMV2, names.DS, names.EC2, names.ELB, names.Organizations, names.WorkSpaces:
+		return arn.Resource[strings.LastIndex(arn.Resource, "/")+1:], nil
+
+	// Only one resource type is taggable, by ID or name.
+	case names.EFS:
+		return resourceTypeIdentifier(arn, "file-system")
+	case names.Firehose:
+		return resourceTypeIdentifier(arn, "deliverystream")
+	case names.Glacier:
+		return resourceTypeIdentifier(arn, "vaults")
+	case names.Kinesis:
+		return resourceTypeIdentifier(arn, "stream")
+
+	// Log groups are tagged by name, e.g. "my-log-group" from "log-group:my-log-group:*".
+	case names.Logs:
+		if v, ok := strings.CutPrefix(arn.Resource, "log-group:"); ok && v != "" {
+			return strings.TrimSuffix(v, ":*"), nil
+		}
+
+	// Queues are tagged by URL.
+	case names.SQS:
+		partition, ok := endpoints.PartitionForRegion(endpoints.DefaultPartitions(), arn.Region)
+		if !ok {
+			return "", fmt.Errorf("unknown partition for Region (%s)", arn.Region)
+		}
+
+		return fmt.Sprintf("https://sqs.%s.%s/%s/%s", arn.Region, partition.DNSSuffix(), arn.AccountID, arn.Resource), nil
+
+	// Domains have no ARN.
+	case names.Route53Domains:
+		return "", fmt.Errorf("tagging %s resources by ARN is not supported", servicePackageName)
+
+	default:
+		return arn.String(), nil
+	}
+
+	return "", fmt.Errorf("tagging %s resource (%s) by ARN is not supported", servicePackageName, arn.Resource)
+}
+
+// resourceTypeIdentifier returns the ID or name from an ARN resource of the form "<resourceType>/<id>".
+func resourceTypeIdentifier(arn arn.ARN, resourceType string) (string, error) {
+	if v, ok := strings.CutPrefix(arn.Resource, resourceType+"/"); ok && v != "" && !strings.Contains(v, "/") {
+		return v, nil
+	}
+
+	return "", fmt.Errorf("tagging %s resource (%s) by ARN is not supported", arn.Service, arn.Resource)
+}
+
+// findTaggerByARN returns the tagger for the resource with the specified ARN and the resource's tagging identifier,
+// along with a Context in which the tagger's operations are to be called.
+// The returned Context's per-resource Region is the resource's Region. The specified Context is not modified.
+func findTaggerByARN(ctx context.Context, client *conns.AWSClient, resourceARN string) (context.Context, tagger, string, error) {
+	arn, err := arn.Parse(resourceARN)
+
+	if err != nil {
+		return ctx, nil, "", err
+	}
+
+	servicePackageName, err := servicePackageNameForARN(arn)
+
+	if err != nil {
+		return ctx, nil, "", err
+	}
+
+	sp, ok := client.ServicePackages[servicePackageName]
+
+	if !ok {
+		return ctx, nil, "", fmt.Errorf("unknown service package: %s", servicePackageName)
+	}
+
+	v, ok := sp.(tagger)
+
+	if !ok {
+		return ctx, nil, "", fmt.Errorf("tagging %s resources by ARN is not supported", servicePackageName)
+	}
+
+	// Regional resources are tagged in the resource's Region.
+	if arn.Region != "" {
+		ctx = contextWithRegion(ctx, arn.Region)
+	}
+
+	identifier, err := taggingIdentifier(servicePackageName, arn)
+
+	if err != nil {
+		return ctx, nil, "", err
+	}
+
+	return ctx, v, identifier, nil
+}
+
+// contextWithRegion returns a copy of the specified Context with the specified per-resource Region.
+func contextWithRegion(ctx context.Context, region string) context.Context {
+	var v conns.InContext
+	if inContext, ok := conns.FromContext(ctx); ok {
+		v = *inContext
+	}
+
+	ctx = conns.NewResourceContext(ctx, v.ServicePackageName, v.ResourceName, v.TypeName)
+
+	inContext, _ := conns.FromContext(ctx)
+	inContext.Region = region
+	inContext.ResourceID = v.ResourceID
+
+	return ctx
+}
+
+// findTagByARN returns the value of the specified tag on the resource with the specified ARN.
+func findTagByARN(ctx context.Context, client *conns.AWSClient, resourceARN, key string) (string, error) {
+	ctx, sp, identifier, err := findTaggerByARN(ctx, client, resourceARN)
+
+	if err != nil {
+		return "", err
+	}
+
+	// ListTags returns the resource's tags via a fresh tagging Context.
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	if err := sp.ListTags(ctx, client, identifier); err != nil {
+		return "", err
+	}
+
+	tagsInContext, _ := tftags.FromContext(ctx)
+	tags := tagsInContext.TagsOut.UnwrapOrDefault()
+
+	if !tags.KeyExists(key) {
+		return "", &retry.NotFoundError{}
+	}
+
+	return aws.ToString(tags.KeyValue(key)), nil
+}
@@ -0,0 +1,365 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package meta_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfmeta "github.com/hashicorp/terraform-provider-aws/internal/service/meta"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestContextWithRegion(t *testing.T) {
+	t.Parallel()
+
+	ctx := conns.NewResourceContext(context.Background(), "meta", "Tag", "aws_tag")
+	inContext, _ := conns.FromContext(ctx)
+	inContext.ResourceID = "test-id"
+
+	got, ok := conns.FromContext(tfmeta.ContextWithRegion(ctx, "eu-west-1")) //lintignore:AWSAT003
+	if !ok {
+		t.Fatal("no resource information in Context")
+	}
+
+	if got, want := got.Region, "eu-west-1"; got != want { //lintignore:AWSAT003
+		t.Errorf("Region = %q, want %q", got, want)
+	}
+	if got, want := got.TypeName, "aws_tag"; got != want {
+		t.Errorf("TypeName = %q, want %q", got, want)
+	}
+	if got, want := got.ResourceID, "test-id"; got != want {
+		t.Errorf("ResourceID = %q, want %q", got, want)
+	}
+	if inContext.Region != "" {
+		t.Errorf("original Context modified, Region = %q", inContext.Region)
+	}
+}
+
+func TestServicePackageNameForARN(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		arn               string
+		wantPackage       string
+		wantIdentifier    string
+		wantErr           bool
+		wantIdentifierErr bool
+	}{
+		{
+			arn:            "arn:aws:ec2:us-west-2:123456789012:vpc/vpc-0123456789abcdef0", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.EC2,
+			wantIdentifier: "vpc-0123456789abcdef0",
+		},
+		{
+			arn:            "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/my-elb", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.ELB,
+			wantIdentifier: "my-elb",
+		},
+		{
+			arn:            "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.ELBV2,
+			wantIdentifier: "arn:aws:elasticloadbalancing:us-west-2:123456789012:loadbalancer/app/my-alb/50dc6c495c0c9188", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			arn:            "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.ELBV2,
+			wantIdentifier: "arn:aws:elasticloadbalancing:us-west-2:123456789012:targetgroup/my-tg/73e2d6bc24d8a067", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			arn:            "arn:aws:states:us-west-2:123456789012:stateMachine:my-state-machine", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.SFN,
+			wantIdentifier: "arn:aws:states:us-west-2:123456789012:stateMachine:my-state-machine", //lintignore:AWSAT003,AWSAT005
+		},
+		{
+			arn:            "arn:aws:sqs:us-west-2:123456789012:my-queue", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.SQS,
+			wantIdentifier: "https://sqs.us-west-2.amazonaws.com/123456789012/my-queue", //lintignore:AWSAT003
+		},
+		{
+			arn:            "arn:aws-cn:sqs:cn-north-1:123456789012:my-queue", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.SQS,
+			wantIdentifier: "https://sqs.cn-north-1.amazonaws.com.cn/123456789012/my-queue", //lintignore:AWSAT003
+		},
+		{
+			arn:            "arn:aws:kinesis:us-west-2:123456789012:stream/my-stream", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.Kinesis,
+			wantIdentifier: "my-stream",
+		},
+		{
+			arn:               "arn:aws:kinesis:us-west-2:123456789012:stream/my-stream/consumer/my-consumer:1616044553", //lintignore:AWSAT003,AWSAT005
+			wantPackage:       names.Kinesis,
+			wantIdentifierErr: true,
+		},
+		{
+			arn:            "arn:aws:firehose:us-west-2:123456789012:deliverystream/my-stream", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.Firehose,
+			wantIdentifier: "my-stream",
+		},
+		{
+			arn:            "arn:aws:logs:us-west-2:123456789012:log-group:my-log-group:*", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.Logs,
+			wantIdentifier: "my-log-group",
+		},
+		{
+			arn:            "arn:aws:elasticfilesystem:us-west-2:123456789012:file-system/fs-01234567", //lintignore:AWSAT003,AWSAT005
+			wantPackage:    names.EFS,
+			wantIdentifier: "fs-01234567",
+		},
+		{
+			arn:               "arn:aws:elasticfilesystem:us-west-2:123456789012:access-point/fsap-01234567", //lintignore:AWSAT003,AWSAT005
+			wantPackage:       names.EFS,
+			wantIdentifierErr: true,
+		},
+		{
+			arn:     "arn:aws:notaservice:us-west-2:123456789012:thing/my-thing", //lintignore:AWSAT003,AWSAT005
+			wantErr: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.arn, func(t *testing.T) {
+			t.Parallel()
+
+			arn, err := arn.Parse(testCase.arn)
+			if err != nil {
+				t.Fatalf("parsing ARN: %s", err)
+			}
+
+			got, err := tfmeta.ServicePackageNameForARN(arn)
+
+			if got, want := err != nil, testCase.wantErr; got != want {
+				t.Fatalf("ServicePackageNameForARN() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if want := testCase.wantPackage; got != want {
+				t.Errorf("ServicePackageNameForARN() = %q, want %q", got, want)
+			}
+
+			identifier, err := tfmeta.TaggingIdentifier(got, arn)
+
+			if got, want := err != nil, testCase.wantIdentifierErr; got != want {
+				t.Fatalf("TaggingIdentifier() err %t, want %t: %v", got, want, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if got, want := identifier, testCase.wantIdentifier; got != want {
+				t.Errorf("TaggingIdentifier() = %q, want %q", got, want)
+			}
+		})
+	}
+}
+
+func TestAccMetaTag_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig_sqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "key", "key1"),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_sqs_queue.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccMetaTag_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig_sqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfmeta.ResourceTag(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccMetaTag_value(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig_sqsQueue(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				Config: testAccTagConfig_sqsQueue(rName, "key1", "value1updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccMetaTag_ec2VPC(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_tag.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, tfmeta.PseudoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagConfig_ec2VPC(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "resource_arn", "aws_vpc.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "value", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTagDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_tag" {
+				continue
+			}
+
+			resourceARN, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+			if err != nil {
+				return err
+			}
+
+			_, err = tfmeta.FindTagByARN(ctx, acctest.Provider.Meta().(*conns.AWSClient), resourceARN, key)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Resource (%s) tag (%s) still exists", resourceARN, key)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTagExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		resourceARN, key, err := tftags.GetResourceID(rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		_, err = tfmeta.FindTagByARN(ctx, acctest.Provider.Meta().(*conns.AWSClient), resourceARN, key)
+
+		return err
+	}
+}
+
+func testAccTagConfig_sqsQueue(rName, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_sqs_queue" "test" {
+  name = %[1]q
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_tag" "test" {
+  resource_arn = aws_sqs_queue.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
+
+func testAccTagConfig_ec2VPC(rName, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_tag" "test" {
+  resource_arn = aws_vpc.test.arn
+  key          = %[2]q
+  value        = %[3]q
+}
+`, rName, key, value)
+}
@@ -1696,7 +1696,8 @@ func statusDBCluster(ctx context.Context, conn *rds.RDS, id string) retry.StateR
 }
 
 func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "creating",
 		Pending: []string{
 			ClusterStatusBackingUp,
 			ClusterStatusCreating,
@@ -1706,11 +1707,11 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusRebooting,
 			ClusterStatusResettingMasterCredentials,
 		},
-		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:          []string{ClusterStatusAvailable},
+		Refresh:         statusDBCluster(ctx, conn, id),
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -1723,7 +1724,8 @@ func waitDBClusterCreated(ctx context.Context, conn *rds.RDS, id string, timeout
 }
 
 func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) { //nolint:unparam
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "modifying",
 		Pending: []string{
 			ClusterStatusBackingUp,
 			ClusterStatusConfiguringIAMDatabaseAuth,
@@ -1733,11 +1735,11 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusScalingCompute,
 			ClusterStatusUpgrading,
 		},
-		Target:     []string{ClusterStatusAvailable},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:          []string{ClusterStatusAvailable},
+		Refresh:         statusDBCluster(ctx, conn, id),
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
@@ -1750,7 +1752,8 @@ func waitDBClusterUpdated(ctx context.Context, conn *rds.RDS, id string, timeout
 }
 
 func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration) (*rds.DBCluster, error) {
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "deleting",
 		Pending: []string{
 			ClusterStatusAvailable,
 			ClusterStatusBackingUp,
@@ -1759,11 +1762,11 @@ func waitDBClusterDeleted(ctx context.Context, conn *rds.RDS, id string, timeout
 			ClusterStatusPromoting,
 			ClusterStatusScalingCompute,
 		},
-		Target:     []string{},
-		Refresh:    statusDBCluster(ctx, conn, id),
-		Timeout:    timeout,
-		MinTimeout: 10 * time.Second,
-		Delay:      30 * time.Second,
+		Target:          []string{},
+		Refresh:         statusDBCluster(ctx, conn, id),
+		Timeout:         timeout,
+		MinPollInterval: 10 * time.Second,
+		Delay:           30 * time.Second,
 	}
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
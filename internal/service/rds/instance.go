@@ -1608,7 +1608,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 
 	var instance *rds.DBInstance
 	var err error
-	if instance, err = waitDBInstanceAvailableSDKv1(ctx, conn, identifier, d.Timeout(schema.TimeoutCreate)); err != nil {
+	if instance, err = waitDBInstanceAvailableSDKv1(ctx, conn, identifier, "creating", d.Timeout(schema.TimeoutCreate)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) create: %s", identifier, err)
 	}
 
@@ -1626,7 +1626,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", identifier, err)
 		}
 
-		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), "modifying", d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", identifier, err)
 		}
 	}
@@ -1639,7 +1639,7 @@ func resourceInstanceCreate(ctx context.Context, d *schema.ResourceData, meta in
 			return sdkdiag.AppendErrorf(diags, "rebooting RDS DB Instance (%s): %s", identifier, err)
 		}
 
-		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+		if _, err := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), "rebooting", d.Timeout(schema.TimeoutUpdate)); err != nil {
 			return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", identifier, err)
 		}
 	}
@@ -1800,7 +1800,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): %s", d.Get("identifier").(string), err)
 			}
 
-			if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, d.Id(), deadline.Remaining()); err != nil {
+			if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, d.Id(), "promoting", deadline.Remaining()); err != nil {
 				return sdkdiag.AppendErrorf(diags, "promoting RDS DB Instance (%s): waiting for completion: %s", d.Get("identifier").(string), err)
 			}
 		} else {
@@ -1900,7 +1900,7 @@ func resourceInstanceUpdate(ctx context.Context, d *schema.ResourceData, meta in
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Get("identifier").(string), err)
 			}
-			_, err = waitDBInstanceAvailableSDKv2(ctx, conn, targetARN.Identifier, deadline.Remaining())
+			_, err = waitDBInstanceAvailableSDKv2(ctx, conn, targetARN.Identifier, "creating", deadline.Remaining())
 			if err != nil {
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): creating Blue/Green Deployment: waiting for Green environment: %s", d.Get("identifier").(string), err)
 			}
@@ -2266,7 +2266,7 @@ func dbInstanceModify(ctx context.Context, conn *rds_sdkv2.Client, resourceID st
 		return err
 	}
 
-	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, resourceID, timeout); err != nil {
+	if _, err := waitDBInstanceAvailableSDKv2(ctx, conn, resourceID, "modifying", timeout); err != nil {
 		return fmt.Errorf("waiting for completion: %w", err)
 	}
 	return nil
@@ -2325,7 +2325,7 @@ func resourceInstanceDelete(ctx context.Context, d *schema.ResourceData, meta in
 				return sdkdiag.AppendErrorf(diags, "updating RDS DB Instance (%s): %s", d.Get("identifier").(string), err)
 			}
 
-			if _, ierr := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); ierr != nil {
+			if _, ierr := waitDBInstanceAvailableSDKv1(ctx, conn, d.Id(), "modifying", d.Timeout(schema.TimeoutUpdate)); ierr != nil {
 				return sdkdiag.AppendErrorf(diags, "waiting for RDS DB Instance (%s) update: %s", d.Get("identifier").(string), ierr)
 			}
 
@@ -2562,7 +2562,49 @@ func statusDBInstanceSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id strin
 	}
 }
 
-func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
+// statusReasonDBInstanceSDKv1 returns the messages of any abnormal status information for a DB instance.
+func statusReasonDBInstanceSDKv1(v any) string {
+	output, ok := v.(*rds.DBInstance)
+	if !ok {
+		return ""
+	}
+
+	var reasons []string
+
+	for _, statusInfo := range output.StatusInfos {
+		if statusInfo == nil || aws.BoolValue(statusInfo.Normal) || aws.StringValue(statusInfo.Message) == "" {
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(statusInfo.Status), aws.StringValue(statusInfo.Message)))
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// statusReasonDBInstanceSDKv2 returns the messages of any abnormal status information for a DB instance.
+func statusReasonDBInstanceSDKv2(v any) string {
+	output, ok := v.(*types.DBInstance)
+	if !ok {
+		return ""
+	}
+
+	var reasons []string
+
+	for _, statusInfo := range output.StatusInfos {
+		if aws.BoolValue(statusInfo.Normal) || aws.StringValue(statusInfo.Message) == "" {
+			continue
+		}
+
+		reasons = append(reasons, fmt.Sprintf("%s: %s", aws.StringValue(statusInfo.Status), aws.StringValue(statusInfo.Message)))
+	}
+
+	return strings.Join(reasons, "; ")
+}
+
+// waitDBInstanceAvailableSDKv1 waits for a DB instance to become available.
+// operation is the verb used in progress log messages, e.g. "creating".
+func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id, operation string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) {
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
@@ -2572,7 +2614,8 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: operation,
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -2590,11 +2633,12 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 			InstanceStatusStorageFull,
 			InstanceStatusUpgrading,
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
-		Timeout: timeout,
+		Target:       []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:      statusDBInstanceSDKv1(ctx, conn, id),
+		StatusReason: statusReasonDBInstanceSDKv1,
+		Timeout:      timeout,
 	}
-	options.Apply(stateConf)
+	options.ApplyTo(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -2605,7 +2649,9 @@ func waitDBInstanceAvailableSDKv1(ctx context.Context, conn *rds.RDS, id string,
 	return nil, err
 }
 
-func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*rds.DBInstance, error) { //nolint:unparam
+// waitDBInstanceAvailableSDKv2 waits for a DB instance to become available.
+// operation is the verb used in progress log messages, e.g. "modifying".
+func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, id, operation string, timeout time.Duration, optFns ...tfresource.OptionsFunc) (*types.DBInstance, error) { //nolint:unparam
 	options := tfresource.Options{
 		PollInterval:              10 * time.Second,
 		Delay:                     1 * time.Minute,
@@ -2615,7 +2661,8 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: operation,
 		Pending: []string{
 			InstanceStatusBackingUp,
 			InstanceStatusConfiguringEnhancedMonitoring,
@@ -2633,15 +2680,16 @@ func waitDBInstanceAvailableSDKv2(ctx context.Context, conn *rds_sdkv2.Client, i
 			InstanceStatusStorageFull,
 			InstanceStatusUpgrading,
 		},
-		Target:  []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
-		Refresh: statusDBInstanceSDKv2(ctx, conn, id),
-		Timeout: timeout,
+		Target:       []string{InstanceStatusAvailable, InstanceStatusStorageOptimization},
+		Refresh:      statusDBInstanceSDKv2(ctx, conn, id),
+		StatusReason: statusReasonDBInstanceSDKv2,
+		Timeout:      timeout,
 	}
-	options.Apply(stateConf)
+	options.ApplyTo(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
-	if output, ok := outputRaw.(*rds.DBInstance); ok {
+	if output, ok := outputRaw.(*types.DBInstance); ok {
 		return output, err
 	}
 
@@ -2658,7 +2706,8 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 		fn(&options)
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &tfresource.StateChangeConf{
+		Operation: "deleting",
 		Pending: []string{
 			InstanceStatusAvailable,
 			InstanceStatusBackingUp,
@@ -2679,7 +2728,7 @@ func waitDBInstanceDeleted(ctx context.Context, conn *rds.RDS, id string, timeou
 		Refresh: statusDBInstanceSDKv1(ctx, conn, id),
 		Timeout: timeout,
 	}
-	options.Apply(stateConf)
+	options.ApplyTo(stateConf)
 
 	outputRaw, err := stateConf.WaitForStateContext(ctx)
 
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	rds_sdkv2 "github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const testDescribeDBInstancesResponse = `<DescribeDBInstancesResponse xmlns="http://rds.amazonaws.com/doc/2014-10-31/">
+  <DescribeDBInstancesResult>
+    <DBInstances>
+      <DBInstance>
+        <DBInstanceIdentifier>test</DBInstanceIdentifier>
+        <DBInstanceStatus>%s</DBInstanceStatus>
+        <StatusInfos>
+          <DBInstanceStatusInfo>
+            <StatusType>read replication</StatusType>
+            <Normal>false</Normal>
+            <Status>error</Status>
+            <Message>Replication has stopped.</Message>
+          </DBInstanceStatusInfo>
+        </StatusInfos>
+      </DBInstance>
+    </DBInstances>
+  </DescribeDBInstancesResult>
+</DescribeDBInstancesResponse>`
+
+func TestWaitDBInstanceAvailableSDKv2(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		status                 string
+		expectTimeout          bool
+		expectLastStatusReason string
+	}{
+		"available": {
+			status: InstanceStatusAvailable,
+		},
+		"modifying": {
+			status:                 InstanceStatusModifying,
+			expectTimeout:          true,
+			expectLastStatusReason: "error: Replication has stopped.",
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Content-Type", "text/xml")
+				fmt.Fprintf(w, testDescribeDBInstancesResponse, testCase.status)
+			}))
+			defer server.Close()
+
+			conn := rds_sdkv2.New(rds_sdkv2.Options{
+				BaseEndpoint:     aws_sdkv2.String(server.URL),
+				Credentials:      aws_sdkv2.AnonymousCredentials{},
+				Region:           "us-west-2",
+				RetryMaxAttempts: 1,
+			})
+
+			output, err := waitDBInstanceAvailableSDKv2(context.Background(), conn, "test", "modifying", 500*time.Millisecond,
+				tfresource.WithDelay(time.Millisecond),
+				tfresource.WithPollInterval(10*time.Millisecond),
+			)
+
+			if testCase.expectTimeout {
+				var timeoutErr *tfresource.TimeoutError
+				if !errors.As(err, &timeoutErr) {
+					t.Fatalf("expected TimeoutError, got %v", err)
+				}
+
+				if got, want := timeoutErr.LastStatusReason, testCase.expectLastStatusReason; got != want {
+					t.Errorf("LastStatusReason = %q, want %q", got, want)
+				}
+
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if output == nil {
+				t.Fatal("expected DB instance")
+			}
+
+			if got, want := aws_sdkv2.ToString(output.DBInstanceIdentifier), "test"; got != want {
+				t.Errorf("DBInstanceIdentifier = %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -32,7 +32,13 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePackageSDKResource {
-	return []*types.ServicePackageSDKResource{}
+	return []*types.ServicePackageSDKResource{
+		{
+			Factory:  resourceTags,
+			TypeName: "aws_resourcegroupstaggingapi_tags",
+			Name:     "Tags",
+		},
+	}
 }
 
 func (p *servicePackage) ServicePackageName() string {
@@ -0,0 +1,315 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	// API limits.
+	getResourcesMaxResourceARNs   = 100
+	tagResourcesMaxResourceARNs   = 20
+	tagResourcesMaxTags           = 50
+	untagResourcesMaxResourceARNs = 20
+	untagResourcesMaxTagKeys      = 50
+)
+
+// @SDKResource("aws_resourcegroupstaggingapi_tags", name="Tags")
+func resourceTags() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceTagsCreate,
+		ReadWithoutTimeout:   resourceTagsRead,
+		UpdateWithoutTimeout: resourceTagsUpdate,
+		DeleteWithoutTimeout: resourceTagsDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceTagsImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"resource_arns": {
+				Type:     schema.TypeSet,
+				Required: true,
+				MinItems: 1,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: verify.ValidARN,
+				},
+			},
+			names.AttrTags: {
+				Type:     schema.TypeMap,
+				Required: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceTagsCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	// Set the ID before tagging so that a partially successful create is tainted, not orphaned.
+	// The ID is opaque and does not change when the set of managed resources changes.
+	d.SetId(id.UniqueId())
+
+	if err := tagResources(ctx, conn, resourceARNs, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	resourceTags, err := findResourceTags(ctx, conn, resourceARNs)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	// Only tags that have the managed value on every resource are kept in state.
+	// Any other tag shows as a difference and is reconciled on the next apply.
+	result := make(map[string]string)
+	for k, v := range tags.Map() {
+		inSync := true
+
+		for _, resourceARN := range resourceARNs {
+			if value := resourceTags[resourceARN].KeyValue(k); value == nil || aws.ToString(value) != v {
+				inSync = false
+				break
+			}
+		}
+
+		if inSync {
+			result[k] = v
+		}
+	}
+
+	if err := d.Set(names.AttrTags, result); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting %s: %s", names.AttrTags, err)
+	}
+
+	return diags
+}
+
+func resourceTagsUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	o, n := d.GetChange("resource_arns")
+	oldResourceARNs, newResourceARNs := flex.Set[string](flex.ExpandStringValueSet(o.(*schema.Set))), flex.Set[string](flex.ExpandStringValueSet(n.(*schema.Set)))
+	removedResourceARNs, addedResourceARNs := oldResourceARNs.Difference(newResourceARNs), newResourceARNs.Difference(oldResourceARNs)
+	keptResourceARNs := newResourceARNs.Difference(addedResourceARNs)
+
+	o, n = d.GetChange(names.AttrTags)
+	oldTags, newTags := tftags.New(ctx, o), tftags.New(ctx, n)
+
+	var errs []error
+
+	// Resources no longer managed have all previously managed tags removed.
+	if err := untagResources(ctx, conn, removedResourceARNs, oldTags); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Newly managed resources have all tags added.
+	if err := tagResources(ctx, conn, addedResourceARNs, newTags); err != nil {
+		errs = append(errs, err)
+	}
+
+	// Other resources have only the changed tags updated.
+	if err := untagResources(ctx, conn, keptResourceARNs, oldTags.Removed(newTags)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := tagResources(ctx, conn, keptResourceARNs, newTags.Updated(oldTags)); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := errors.Join(errs...); err != nil {
+		// Keep the prior state so that the next apply retries every change. Tagging and untagging are idempotent.
+		d.Partial(true)
+
+		return sdkdiag.AppendErrorf(diags, "updating Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return append(diags, resourceTagsRead(ctx, d, meta)...)
+}
+
+func resourceTagsDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs := flex.ExpandStringValueSet(d.Get("resource_arns").(*schema.Set))
+	tags := tftags.New(ctx, d.Get(names.AttrTags).(map[string]interface{}))
+
+	if err := untagResources(ctx, conn, resourceARNs, tags); err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Resource Groups Tagging API Tags (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func resourceTagsImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// The import ID is the list of resource ARNs, not the resource ID.
+	resourceARNs, err := tagsParseImportID(d.Id())
+
+	if err != nil {
+		return nil, err
+	}
+
+	d.SetId(id.UniqueId())
+	// No tags are managed until the next apply sets the configured tags.
+	if err := d.Set("resource_arns", resourceARNs); err != nil {
+		return nil, fmt.Errorf("setting resource_arns: %w", err)
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+const tagsImportIDSeparator = ","
+
+func tagsParseImportID(id string) ([]string, error) {
+	parts := strings.Split(id, tagsImportIDSeparator)
+
+	for _, part := range parts {
+		if !arn.IsARN(part) {
+			return nil, fmt.Errorf("unexpected format for import ID (%[1]s), expected '<resource ARN>[%[2]s<resource ARN>]...'", id, tagsImportIDSeparator)
+		}
+	}
+
+	return parts, nil
+}
+
+// findResourceTags returns the tags of each of the specified resources.
+// Resources that have no tags, or do not exist, are not present in the result.
+func findResourceTags(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string) (map[string]tftags.KeyValueTags, error) {
+	output := make(map[string]tftags.KeyValueTags)
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, getResourcesMaxResourceARNs) {
+		input := &resourcegroupstaggingapi.GetResourcesInput{
+			ResourceARNList: chunk,
+		}
+
+		pages := resourcegroupstaggingapi.NewGetResourcesPaginator(conn, input)
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.ResourceTagMappingList {
+				output[aws.ToString(v.ResourceARN)] = KeyValueTags(ctx, v.Tags)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+// tagResources adds or updates the specified tags on each of the specified resources.
+// An error is returned for each resource that could not be tagged.
+func tagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tags tftags.KeyValueTags) error {
+	if len(resourceARNs) == 0 || len(tags) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, tagResourcesMaxResourceARNs) {
+		for _, v := range tags.Chunks(tagResourcesMaxTags) {
+			input := &resourcegroupstaggingapi.TagResourcesInput{
+				ResourceARNList: chunk,
+				Tags:            v.Map(),
+			}
+
+			output, err := conn.TagResources(ctx, input)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("tagging resources (%s): %w", strings.Join(chunk, ", "), err))
+				continue
+			}
+
+			errs = append(errs, failedResourcesErrors("tagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// untagResources removes the specified tags' keys from each of the specified resources.
+// An error is returned for each resource that could not be untagged.
+func untagResources(ctx context.Context, conn *resourcegroupstaggingapi.Client, resourceARNs []string, tags tftags.KeyValueTags) error {
+	if len(resourceARNs) == 0 || len(tags) == 0 {
+		return nil
+	}
+
+	var errs []error
+
+	for _, chunk := range tfslices.Chunks(resourceARNs, untagResourcesMaxResourceARNs) {
+		for _, v := range tags.Chunks(untagResourcesMaxTagKeys) {
+			input := &resourcegroupstaggingapi.UntagResourcesInput{
+				ResourceARNList: chunk,
+				TagKeys:         v.Keys(),
+			}
+
+			output, err := conn.UntagResources(ctx, input)
+
+			if err != nil {
+				errs = append(errs, fmt.Errorf("untagging resources (%s): %w", strings.Join(chunk, ", "), err))
+				continue
+			}
+
+			errs = append(errs, failedResourcesErrors("untagging", output.FailedResourcesMap)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+// failedResourcesErrors returns an error for each resource in a Resource Groups Tagging API FailedResourcesMap.
+func failedResourcesErrors(operation string, failedResources map[string]types.FailureInfo) []error {
+	resourceARNs := make([]string, 0, len(failedResources))
+	for k := range failedResources {
+		resourceARNs = append(resourceARNs, k)
+	}
+	sort.Strings(resourceARNs)
+
+	errs := make([]error, 0, len(resourceARNs))
+	for _, resourceARN := range resourceARNs {
+		v := failedResources[resourceARN]
+		errs = append(errs, fmt.Errorf("%s resource (%s): %s (%d): %s", operation, resourceARN, v.ErrorCode, v.StatusCode, aws.ToString(v.ErrorMessage)))
+	}
+
+	return errs
+}
@@ -0,0 +1,221 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resourcegroupstaggingapi_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfresourcegroupstaggingapi "github.com/hashicorp/terraform-provider-aws/internal/service/resourcegroupstaggingapi"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccResourceGroupsTaggingAPITags_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx, "Key1"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_basic(rName, 2, "Key1", "Value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName, "Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.0", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "resource_arns.*", "aws_vpc.test.1", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key1", "Value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateIdFunc: testAccTagsImportStateIDFunc(resourceName),
+				// A new ID is assigned on import, and no tags are managed immediately after import.
+				ImportStateCheck: func(s []*terraform.InstanceState) error {
+					if len(s) != 1 {
+						return fmt.Errorf("expected 1 imported resource, got %d", len(s))
+					}
+
+					if s[0].ID == "" {
+						return fmt.Errorf("expected imported resource ID to be set")
+					}
+
+					if got, want := s[0].Attributes["resource_arns.#"], "2"; got != want {
+						return fmt.Errorf("expected resource_arns.# to be %s, got %s", want, got)
+					}
+
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestAccResourceGroupsTaggingAPITags_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_resourcegroupstaggingapi_tags.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ResourceGroupsTaggingAPIEndpointID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTagsDestroy(ctx, "Key2"),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTagsConfig_basic(rName, 1, "Key1", "Value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName, "Key1", "Value1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "1"),
+				),
+			},
+			{
+				Config: testAccTagsConfig_basic(rName, 3, "Key2", "Value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckTagsExist(ctx, resourceName, "Key2", "Value2"),
+					testAccCheckTagsNotExist(ctx, resourceName, "Key1"),
+					resource.TestCheckResourceAttr(resourceName, "resource_arns.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "tags.Key2", "Value2"),
+				),
+			},
+		},
+	})
+}
+
+// testAccCheckTagsExist checks that every resource managed by the resource has the specified tag.
+func testAccCheckTagsExist(ctx context.Context, n, key, value string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+		resourceARNs := testAccTagsResourceARNs(rs)
+		output, err := tfresourcegroupstaggingapi.FindResourceTags(ctx, conn, resourceARNs)
+
+		if err != nil {
+			return err
+		}
+
+		for _, resourceARN := range resourceARNs {
+			if got := output[resourceARN].KeyValue(key); got == nil || *got != value {
+				return fmt.Errorf("Resource (%s) tag (%s) not set to %q", resourceARN, key, value)
+			}
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckTagsNotExist checks that no resource managed by the resource has the specified tag key.
+func testAccCheckTagsNotExist(ctx context.Context, n, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		return testAccCheckResourceTagsNotExist(ctx, rs, key)
+	}
+}
+
+func testAccCheckTagsDestroy(ctx context.Context, key string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_resourcegroupstaggingapi_tags" {
+				continue
+			}
+
+			if err := testAccCheckResourceTagsNotExist(ctx, rs, key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckResourceTagsNotExist(ctx context.Context, rs *terraform.ResourceState, key string) error {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).ResourceGroupsTaggingAPIClient(ctx)
+
+	resourceARNs := testAccTagsResourceARNs(rs)
+	output, err := tfresourcegroupstaggingapi.FindResourceTags(ctx, conn, resourceARNs)
+
+	if err != nil {
+		return err
+	}
+
+	for _, resourceARN := range resourceARNs {
+		if output[resourceARN].KeyExists(key) {
+			return fmt.Errorf("Resource (%s) tag (%s) still exists", resourceARN, key)
+		}
+	}
+
+	return nil
+}
+
+func testAccTagsImportStateIDFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return strings.Join(testAccTagsResourceARNs(rs), ","), nil
+	}
+}
+
+func testAccTagsResourceARNs(rs *terraform.ResourceState) []string {
+	var resourceARNs []string
+
+	for k, v := range rs.Primary.Attributes {
+		if strings.HasPrefix(k, "resource_arns.") && k != "resource_arns.#" {
+			resourceARNs = append(resourceARNs, v)
+		}
+	}
+
+	return resourceARNs
+}
+
+func testAccTagsConfig_basic(rName string, count int, key, value string) string {
+	return fmt.Sprintf(`
+resource "aws_vpc" "test" {
+  count = %[2]d
+
+  cidr_block = "10.0.0.0/16"
+
+  tags = {
+    Name = %[1]q
+  }
+
+  lifecycle {
+    ignore_changes = [tags, tags_all]
+  }
+}
+
+resource "aws_resourcegroupstaggingapi_tags" "test" {
+  resource_arns = aws_vpc.test[*].arn
+
+  tags = {
+    %[3]q = %[4]q
+  }
+}
+`, rName, count, key, value)
+}
@@ -60,7 +60,7 @@ func resourceVPCAssociationAuthorizationCreate(ctx context.Context, d *schema.Re
 		HostedZoneId: aws.String(d.Get("zone_id").(string)),
 		VPC: &route53.VPC{
 			VPCId:     aws.String(d.Get("vpc_id").(string)),
-			VPCRegion: aws.String(meta.(*conns.AWSClient).Region),
+			VPCRegion: aws.String(meta.(*conns.AWSClient).Region(ctx)),
 		},
 	}
 
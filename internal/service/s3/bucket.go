@@ -714,7 +714,7 @@ func resourceBucketCreate(ctx context.Context, d *schema.ResourceData, meta inte
 	connSDKv2 := meta.(*conns.AWSClient).S3Client(ctx)
 
 	bucket := create.Name(d.Get("bucket").(string), d.Get("bucket_prefix").(string))
-	awsRegion := meta.(*conns.AWSClient).Region
+	awsRegion := meta.(*conns.AWSClient).Region(ctx)
 
 	// Special case: us-east-1 does not return error if the bucket already exists and is owned by
 	// current account. It also resets the Bucket ACLs.
@@ -1486,7 +1486,7 @@ func WebsiteDomainURL(client *conns.AWSClient, region string) string {
 	if isOldRegion(region) {
 		return fmt.Sprintf("s3-website-%s.amazonaws.com", region) //lintignore:AWSR001
 	}
-	return client.RegionalHostname("s3-website")
+	return fmt.Sprintf("s3-website.%s.%s", region, client.DNSSuffix)
 }
 
 func websiteEndpoint(ctx context.Context, client *conns.AWSClient, d *schema.ResourceData) (*S3Website, error) {
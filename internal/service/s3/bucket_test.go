@@ -2423,7 +2423,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.UsEast1RegionID,
 			},
 			LocationConstraint: "",
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.UsEast1RegionID, acctest.PartitionDNSSuffix()),
@@ -2431,7 +2430,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.UsWest2RegionID,
 			},
 			LocationConstraint: endpoints.UsWest2RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.UsWest2RegionID, acctest.PartitionDNSSuffix()),
@@ -2439,7 +2437,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.UsWest1RegionID,
 			},
 			LocationConstraint: endpoints.UsWest1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.UsWest1RegionID, acctest.PartitionDNSSuffix()),
@@ -2447,7 +2444,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.EuWest1RegionID,
 			},
 			LocationConstraint: endpoints.EuWest1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.EuWest1RegionID, acctest.PartitionDNSSuffix()),
@@ -2455,7 +2451,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.EuWest3RegionID,
 			},
 			LocationConstraint: endpoints.EuWest3RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", endpoints.EuWest3RegionID, acctest.PartitionDNSSuffix()),
@@ -2463,7 +2458,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.EuCentral1RegionID,
 			},
 			LocationConstraint: endpoints.EuCentral1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", endpoints.EuCentral1RegionID, acctest.PartitionDNSSuffix()),
@@ -2471,7 +2465,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.ApSouth1RegionID,
 			},
 			LocationConstraint: endpoints.ApSouth1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", endpoints.ApSouth1RegionID, acctest.PartitionDNSSuffix()),
@@ -2479,7 +2472,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.ApSoutheast1RegionID,
 			},
 			LocationConstraint: endpoints.ApSoutheast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.ApSoutheast1RegionID, acctest.PartitionDNSSuffix()),
@@ -2487,7 +2479,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.ApNortheast1RegionID,
 			},
 			LocationConstraint: endpoints.ApNortheast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.ApNortheast1RegionID, acctest.PartitionDNSSuffix()),
@@ -2495,7 +2486,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.ApSoutheast2RegionID,
 			},
 			LocationConstraint: endpoints.ApSoutheast2RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.ApSoutheast2RegionID, acctest.PartitionDNSSuffix()),
@@ -2503,7 +2493,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.ApNortheast2RegionID,
 			},
 			LocationConstraint: endpoints.ApNortheast2RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", endpoints.ApNortheast2RegionID, acctest.PartitionDNSSuffix()),
@@ -2511,7 +2500,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.SaEast1RegionID,
 			},
 			LocationConstraint: endpoints.SaEast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.SaEast1RegionID, acctest.PartitionDNSSuffix()),
@@ -2519,7 +2507,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.UsGovEast1RegionID,
 			},
 			LocationConstraint: endpoints.UsGovEast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.%s", endpoints.UsGovEast1RegionID, acctest.PartitionDNSSuffix()),
@@ -2527,7 +2514,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com",
-				Region:    endpoints.UsGovWest1RegionID,
 			},
 			LocationConstraint: endpoints.UsGovWest1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website-%s.%s", endpoints.UsGovWest1RegionID, acctest.PartitionDNSSuffix()),
@@ -2535,7 +2521,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "c2s.ic.gov",
-				Region:    endpoints.UsIsoEast1RegionID,
 			},
 			LocationConstraint: endpoints.UsIsoEast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.c2s.ic.gov", endpoints.UsIsoEast1RegionID),
@@ -2543,7 +2528,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "sc2s.sgov.gov",
-				Region:    endpoints.UsIsobEast1RegionID,
 			},
 			LocationConstraint: endpoints.UsIsobEast1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.sc2s.sgov.gov", endpoints.UsIsobEast1RegionID),
@@ -2551,7 +2535,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com.cn",
-				Region:    endpoints.CnNorthwest1RegionID,
 			},
 			LocationConstraint: endpoints.CnNorthwest1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.amazonaws.com.cn", endpoints.CnNorthwest1RegionID),
@@ -2559,7 +2542,6 @@ func TestWebsiteEndpoint(t *testing.T) {
 		{
 			TestingClient: &conns.AWSClient{
 				DNSSuffix: "amazonaws.com.cn",
-				Region:    endpoints.CnNorth1RegionID,
 			},
 			LocationConstraint: endpoints.CnNorth1RegionID,
 			Expected:           fmt.Sprintf("bucket-name.s3-website.%s.amazonaws.com.cn", endpoints.CnNorth1RegionID),
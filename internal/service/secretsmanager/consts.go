@@ -10,3 +10,7 @@ import (
 const (
 	PropagationTimeout = 2 * time.Minute
 )
+
+const (
+	secretVersionStageCurrent = "AWSCURRENT"
+)
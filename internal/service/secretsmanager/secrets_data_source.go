@@ -74,7 +74,7 @@ func dataSourceSecretsRead(ctx context.Context, d *schema.ResourceData, meta int
 		names = append(names, aws.StringValue(r.Name))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("arns", arns)
 	d.Set("names", names)
 
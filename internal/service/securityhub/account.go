@@ -195,7 +195,7 @@ func resourceAccountDelete(ctx context.Context, d *schema.ResourceData, meta int
 
 func FindHub(ctx context.Context, meta *conns.AWSClient) (*securityhub.DescribeHubOutput, error) {
 	input := &securityhub.DescribeHubInput{
-		HubArn: aws.String(accountHubARN(meta)),
+		HubArn: aws.String(accountHubARN(ctx, meta)),
 	}
 	return findHub(ctx, meta.SecurityHubClient(ctx), input)
 }
@@ -222,6 +222,6 @@ func findHub(ctx context.Context, conn *securityhub.Client, input *securityhub.D
 }
 
 // Security Hub ARN: https://docs.aws.amazon.com/service-authorization/latest/reference/list_awssecurityhub.html#awssecurityhub-resources-for-iam-policies
-func accountHubARN(meta *conns.AWSClient) string {
-	return fmt.Sprintf("arn:%s:securityhub:%s:%s:hub/default", meta.Partition, meta.Region, meta.AccountID)
+func accountHubARN(ctx context.Context, meta *conns.AWSClient) string {
+	return fmt.Sprintf("arn:%s:securityhub:%s:%s:hub/default", meta.Partition, meta.Region(ctx), meta.AccountID)
 }
@@ -167,7 +167,7 @@ func testAccCheckDomainIdentityARN(n string, domain string) resource.TestCheckFu
 		expected := arn.ARN{
 			AccountID: awsClient.AccountID,
 			Partition: awsClient.Partition,
-			Region:    awsClient.Region,
+			Region:    acctest.Region(),
 			Resource:  fmt.Sprintf("identity/%s", strings.TrimSuffix(domain, ".")),
 			Service:   "ses",
 		}
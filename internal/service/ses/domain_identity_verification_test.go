@@ -122,7 +122,7 @@ func testAccCheckDomainIdentityVerificationPassed(ctx context.Context, n string)
 		expected := arn.ARN{
 			AccountID: acctest.Provider.Meta().(*conns.AWSClient).AccountID,
 			Partition: acctest.Provider.Meta().(*conns.AWSClient).Partition,
-			Region:    acctest.Provider.Meta().(*conns.AWSClient).Region,
+			Region:    acctest.Provider.Meta().(*conns.AWSClient).Region(ctx),
 			Resource:  fmt.Sprintf("identity/%s", domain),
 			Service:   "ses",
 		}
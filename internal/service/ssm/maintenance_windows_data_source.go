@@ -85,7 +85,7 @@ func dataMaintenanceWindowsRead(ctx context.Context, d *schema.ResourceData, met
 		windowIDs = append(windowIDs, aws.StringValue(r.WindowId))
 	}
 
-	d.SetId(meta.(*conns.AWSClient).Region)
+	d.SetId(meta.(*conns.AWSClient).Region(ctx))
 	d.Set("ids", windowIDs)
 
 	return diags
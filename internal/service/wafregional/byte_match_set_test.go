@@ -268,7 +268,7 @@ func TestAccWAFRegionalByteMatchSet_disappears(t *testing.T) {
 func testAccCheckByteMatchSetDisappears(ctx context.Context, v *waf.ByteMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn(ctx)
-		region := acctest.Provider.Meta().(*conns.AWSClient).Region
+		region := acctest.Provider.Meta().(*conns.AWSClient).Region(ctx)
 
 		wr := tfwafregional.NewRetryer(conn, region)
 		_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
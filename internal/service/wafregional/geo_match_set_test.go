@@ -226,7 +226,7 @@ func testAccCheckGeoMatchSetIdDiffers(before, after *waf.GeoMatchSet) resource.T
 func testAccCheckGeoMatchSetDisappears(ctx context.Context, v *waf.GeoMatchSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn(ctx)
-		region := acctest.Provider.Meta().(*conns.AWSClient).Region
+		region := acctest.Provider.Meta().(*conns.AWSClient).Region(ctx)
 
 		wr := tfwafregional.NewRetryer(conn, region)
 		_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
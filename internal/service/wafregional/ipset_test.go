@@ -359,7 +359,7 @@ func TestDiffIPSetDescriptors(t *testing.T) {
 func testAccCheckIPSetDisappears(ctx context.Context, v *waf.IPSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn(ctx)
-		region := acctest.Provider.Meta().(*conns.AWSClient).Region
+		region := acctest.Provider.Meta().(*conns.AWSClient).Region(ctx)
 
 		wr := tfwafregional.NewRetryer(conn, region)
 		_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
@@ -164,7 +164,7 @@ func testAccRegexPatternSet_disappears(t *testing.T) {
 func testAccCheckRegexPatternSetDisappears(ctx context.Context, set *waf.RegexPatternSet) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn(ctx)
-		region := acctest.Provider.Meta().(*conns.AWSClient).Region
+		region := acctest.Provider.Meta().(*conns.AWSClient).Region(ctx)
 
 		wr := tfwafregional.NewRetryer(conn, region)
 		_, err := wr.RetryWithToken(ctx, func(token *string) (interface{}, error) {
@@ -284,7 +284,7 @@ func TestAccWAFRegionalRuleGroup_noActivatedRules(t *testing.T) {
 func testAccCheckRuleGroupDisappears(ctx context.Context, group *waf.RuleGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).WAFRegionalConn(ctx)
-		region := acctest.Provider.Meta().(*conns.AWSClient).Region
+		region := acctest.Provider.Meta().(*conns.AWSClient).Region(ctx)
 
 		rResp, err := conn.ListActivatedRulesInRuleGroupWithContext(ctx, &waf.ListActivatedRulesInRuleGroupInput{
 			RuleGroupId: group.RuleGroupId,
@@ -9,6 +9,14 @@ import (
 	"github.com/hashicorp/terraform-plugin-log/tfsdklog"
 )
 
+type contextKey int
+
+const (
+	regionContextKey contextKey = iota
+	resourceTypeContextKey
+	orchestratorDeleteContextKey
+)
+
 func Context(region string) context.Context {
 	ctx := context.Background()
 
@@ -16,5 +24,33 @@ func Context(region string) context.Context {
 
 	ctx = logger(ctx, "sweeper", region)
 
+	ctx = context.WithValue(ctx, regionContextKey, region)
+
 	return ctx
 }
+
+func withResourceType(ctx context.Context, resourceType string) context.Context {
+	ctx = logWithResourceType(ctx, resourceType)
+
+	return context.WithValue(ctx, resourceTypeContextKey, resourceType)
+}
+
+func regionFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(regionContextKey).(string)
+	return v
+}
+
+func resourceTypeFromContext(ctx context.Context) string {
+	v, _ := ctx.Value(resourceTypeContextKey).(string)
+	return v
+}
+
+// withOrchestratorDelete marks API calls made with the returned context as made by SweepOrchestrator to delete a resource.
+func withOrchestratorDelete(ctx context.Context) context.Context {
+	return context.WithValue(ctx, orchestratorDeleteContextKey, true)
+}
+
+func isOrchestratorDelete(ctx context.Context) bool {
+	v, _ := ctx.Value(orchestratorDeleteContextKey).(bool)
+	return v
+}
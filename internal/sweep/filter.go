@@ -0,0 +1,168 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+)
+
+// Options configures how sweepers delete resources.
+type Options struct {
+	// DryRun lists the resources that would be swept without deleting them.
+	DryRun bool
+	// Filter selects the resources that are swept.
+	Filter Filter
+	// ReportPath is the file to which a JSON report of swept and skipped resources is written.
+	ReportPath string
+}
+
+// Filter selects the resources that are swept.
+// A resource's name is used for name prefix matching, or its ID if it has no name attribute.
+type Filter struct {
+	// NamePrefixes, if set, only allows resources whose name begins with one of the prefixes.
+	NamePrefixes []string
+	// ExcludeNamePrefixes denies resources whose name begins with any of the prefixes.
+	ExcludeNamePrefixes []string
+	// Tags, if set, only allows resources with all of the tags. An empty value matches any value.
+	Tags map[string]string
+	// ExcludeTags denies resources with any of the tags. An empty value matches any value.
+	ExcludeTags map[string]string
+}
+
+// IsEmpty returns whether the filter allows every resource.
+func (f Filter) IsEmpty() bool {
+	return !f.readsNames() && !f.readsTags()
+}
+
+func (f Filter) readsNames() bool {
+	return len(f.NamePrefixes) > 0 || len(f.ExcludeNamePrefixes) > 0
+}
+
+func (f Filter) readsTags() bool {
+	return len(f.Tags) > 0 || len(f.ExcludeTags) > 0
+}
+
+// match returns whether a resource is allowed by the filter and, if not, the reason.
+// name is empty if the resource's name could not be determined and tags is nil if the resource's tags could not be determined.
+func (f Filter) match(name string, tags map[string]string) (bool, string) {
+	// Never sweep a resource whose name is unknown if names are used for filtering.
+	if f.readsNames() && name == "" {
+		return false, "name could not be determined"
+	}
+
+	if len(f.NamePrefixes) > 0 && !hasAnyPrefix(name, f.NamePrefixes) {
+		return false, fmt.Sprintf("name %q does not match any allowed name prefix", name)
+	}
+
+	for _, prefix := range f.ExcludeNamePrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return false, fmt.Sprintf("name %q matches excluded name prefix %q", name, prefix)
+		}
+	}
+
+	if !f.readsTags() {
+		return true, ""
+	}
+
+	// Never sweep a resource whose tags are unknown if tags are used for filtering.
+	if tags == nil {
+		return false, "tags could not be determined"
+	}
+
+	for k, v := range f.Tags {
+		if !hasTag(tags, k, v) {
+			return false, fmt.Sprintf("missing required tag %s", formatTag(k, v))
+		}
+	}
+
+	for k, v := range f.ExcludeTags {
+		if hasTag(tags, k, v) {
+			return false, fmt.Sprintf("has excluded tag %s", formatTag(k, v))
+		}
+	}
+
+	return true, ""
+}
+
+func hasAnyPrefix(s string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(s, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func hasTag(tags map[string]string, key, value string) bool {
+	v, ok := tags[key]
+
+	return ok && (value == "" || v == value)
+}
+
+func formatTag(key, value string) string {
+	if value == "" {
+		return key
+	}
+
+	return key + "=" + value
+}
+
+var sweepOptions = sync.OnceValues(optionsFromEnv)
+
+// optionsFromEnv returns sweeper options from environment variables.
+func optionsFromEnv() (*Options, error) {
+	options := &Options{
+		Filter: Filter{
+			NamePrefixes:        splitList(os.Getenv(envvar.SweepNamePrefixes)),
+			ExcludeNamePrefixes: splitList(os.Getenv(envvar.SweepExcludeNamePrefixes)),
+			Tags:                splitTags(os.Getenv(envvar.SweepTags)),
+			ExcludeTags:         splitTags(os.Getenv(envvar.SweepExcludeTags)),
+		},
+		ReportPath: os.Getenv(envvar.SweepReport),
+	}
+
+	if v := os.Getenv(envvar.SweepDryRun); v != "" {
+		dryRun, err := strconv.ParseBool(v)
+		if err != nil {
+			return nil, fmt.Errorf("environment variable %s: %w", envvar.SweepDryRun, err)
+		}
+		options.DryRun = dryRun
+	}
+
+	return options, nil
+}
+
+func splitList(s string) []string {
+	var l []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			l = append(l, v)
+		}
+	}
+
+	return l
+}
+
+func splitTags(s string) map[string]string {
+	l := splitList(s)
+	if len(l) == 0 {
+		return nil
+	}
+
+	tags := make(map[string]string, len(l))
+	for _, v := range l {
+		k, v, _ := strings.Cut(v, "=")
+		tags[strings.TrimSpace(k)] = strings.TrimSpace(v)
+	}
+
+	return tags
+}
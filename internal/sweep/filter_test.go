@@ -0,0 +1,224 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+func TestFilterMatch(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name     string
+		filter   Filter
+		resource string
+		tags     map[string]string
+		expected bool
+	}{
+		{
+			name:     "empty filter",
+			resource: "anything",
+			expected: true,
+		},
+		{
+			name:     "name prefix match",
+			filter:   Filter{NamePrefixes: []string{"tf-acc-test", "tf-test"}},
+			resource: "tf-test-123",
+			expected: true,
+		},
+		{
+			name:     "name prefix no match",
+			filter:   Filter{NamePrefixes: []string{"tf-acc-test"}},
+			resource: "production",
+		},
+		{
+			name:     "excluded name prefix",
+			filter:   Filter{NamePrefixes: []string{"tf-"}, ExcludeNamePrefixes: []string{"tf-keep"}},
+			resource: "tf-keep-me",
+		},
+		{
+			name:   "unknown name",
+			filter: Filter{ExcludeNamePrefixes: []string{"tf-keep"}},
+		},
+		{
+			name:     "required tag any value",
+			filter:   Filter{Tags: map[string]string{"Sandbox": ""}},
+			resource: "r",
+			tags:     map[string]string{"Sandbox": "team-a"},
+			expected: true,
+		},
+		{
+			name:     "required tag value mismatch",
+			filter:   Filter{Tags: map[string]string{"Owner": "ci"}},
+			resource: "r",
+			tags:     map[string]string{"Owner": "alice"},
+		},
+		{
+			name:     "excluded tag",
+			filter:   Filter{ExcludeTags: map[string]string{"DoNotSweep": ""}},
+			resource: "r",
+			tags:     map[string]string{"DoNotSweep": "true"},
+		},
+		{
+			name:     "excluded tag not present",
+			filter:   Filter{ExcludeTags: map[string]string{"DoNotSweep": ""}},
+			resource: "r",
+			tags:     map[string]string{},
+			expected: true,
+		},
+		{
+			name:     "unknown tags",
+			filter:   Filter{ExcludeTags: map[string]string{"DoNotSweep": ""}},
+			resource: "r",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, reason := testCase.filter.match(testCase.resource, testCase.tags)
+
+			if got != testCase.expected {
+				t.Errorf("got %t (%s), want %t", got, reason, testCase.expected)
+			}
+			if !got && reason == "" {
+				t.Error("expected reason for skipped resource")
+			}
+		})
+	}
+}
+
+func TestSplitTags(t *testing.T) {
+	t.Parallel()
+
+	got := splitTags(" Owner=ci, Sandbox ,,Env = test")
+	want := map[string]string{
+		"Owner":   "ci",
+		"Sandbox": "",
+		"Env":     "test",
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+type testSweepable struct {
+	id, name string
+	tags     map[string]string
+	deleted  *bool
+}
+
+func (s testSweepable) Delete(context.Context, time.Duration, ...tfresource.OptionsFunc) error {
+	*s.deleted = true
+	return nil
+}
+
+func (s testSweepable) Describe(context.Context, bool, bool) (string, string, map[string]string, error) {
+	return s.id, s.name, s.tags, nil
+}
+
+func TestSweepOrchestratorDryRun(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.WithValue(Context("us-west-2"), resourceTypeContextKey, "aws_test")
+	path := filepath.Join(t.TempDir(), "report.json")
+	options := &Options{
+		DryRun: true,
+		Filter: Filter{
+			NamePrefixes: []string{"tf-acc-test"},
+		},
+		ReportPath: path,
+	}
+
+	var deleted1, deleted2 bool
+	sweepables := []Sweepable{
+		testSweepable{id: "id-1", name: "tf-acc-test-1", deleted: &deleted1},
+		testSweepable{id: "id-2", name: "shared", deleted: &deleted2},
+	}
+
+	if err := sweepOrchestrator(ctx, options, newReport(), sweepables); err != nil {
+		t.Fatal(err)
+	}
+
+	if deleted1 || deleted2 {
+		t.Error("resource deleted in dry-run mode")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got report
+	if err := json.Unmarshal(b, &got); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]*regionReport{
+		"us-west-2": {
+			Swept: []reportEntry{
+				{ID: "id-1", Name: "tf-acc-test-1", Type: "aws_test"},
+			},
+			Skipped: []reportEntry{
+				{ID: "id-2", Name: "shared", Reason: `name "shared" does not match any allowed name prefix`, Type: "aws_test"},
+			},
+			Failed: []reportEntry{},
+		},
+	}
+
+	if !got.DryRun {
+		t.Error("expected dry_run in report")
+	}
+	if diff := cmp.Diff(got.Regions, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestReadOnlyExempt(t *testing.T) {
+	t.Parallel()
+
+	ctx := Context("us-west-2")
+	options := &Options{
+		Filter: Filter{
+			ExcludeTags: map[string]string{"DoNotSweep": ""},
+		},
+	}
+	r := newReport()
+	exempt := readOnlyExempt(options, r, "us-west-2")
+
+	if !exempt(withOrchestratorDelete(ctx), "EC2", "DeleteVpc") {
+		t.Error("deletion by SweepOrchestrator not allowed")
+	}
+
+	if exempt(ctx, "API Gateway", "DeleteRestApi") {
+		t.Error("direct deletion by sweeper allowed")
+	}
+
+	want := []reportEntry{
+		{Reason: "API Gateway DeleteRestApi called directly by sweeper, which cannot be filtered"},
+	}
+
+	if diff := cmp.Diff(r.region("us-west-2").Skipped, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	options.DryRun = true
+
+	if exempt(withOrchestratorDelete(ctx), "EC2", "DeleteVpc") {
+		t.Error("deletion allowed in dry-run mode")
+	}
+}
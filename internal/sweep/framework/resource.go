@@ -5,6 +5,7 @@ package framework
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"strings"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/exp/maps"
 )
 
@@ -118,6 +120,47 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the swept resource's ID and name from its attributes.
+// A resource with no name attribute is named by its ID. If the sweeper does not set the name of a resource that has one, name is empty.
+// Tags are never read, so resources swept by Plugin Framework sweepers do not match tag filters.
+func (sr *sweepResource) Describe(ctx context.Context, readName, readTags bool) (string, string, map[string]string, error) {
+	var id, name string
+	var hasName bool
+
+	for _, attr := range sr.attributes {
+		switch attr.path {
+		case names.AttrID:
+			id = fmt.Sprint(attr.value)
+		case names.AttrName:
+			name = fmt.Sprint(attr.value)
+			hasName = true
+		}
+	}
+
+	if id == "" && len(sr.attributes) > 0 {
+		id = fmt.Sprint(sr.attributes[0].value)
+	}
+
+	if hasName || !readName {
+		return id, name, nil, nil
+	}
+
+	resource, err := sr.factory(ctx)
+
+	if err != nil {
+		return id, name, nil, err
+	}
+
+	var response fwresource.SchemaResponse
+	resource.Schema(ctx, fwresource.SchemaRequest{}, &response)
+
+	if _, ok := response.Schema.Attributes[names.AttrName]; !ok {
+		name = id
+	}
+
+	return id, name, nil, nil
+}
+
 func deleteResource(ctx context.Context, state tfsdk.State, resource fwresource.Resource) error {
 	var response fwresource.DeleteResponse
 	resource.Delete(ctx, fwresource.DeleteRequest{State: state}, &response)
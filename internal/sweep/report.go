@@ -0,0 +1,96 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"encoding/json"
+	"os"
+	"sync"
+)
+
+// report is a machine-readable record of swept and skipped resources per region.
+type report struct {
+	DryRun  bool                     `json:"dry_run"`
+	Regions map[string]*regionReport `json:"regions"`
+
+	lock sync.Mutex
+}
+
+type regionReport struct {
+	Swept   []reportEntry `json:"swept"`
+	Skipped []reportEntry `json:"skipped"`
+	Failed  []reportEntry `json:"failed"`
+}
+
+type reportEntry struct {
+	Error  string            `json:"error,omitempty"`
+	ID     string            `json:"id,omitempty"`
+	Name   string            `json:"name,omitempty"`
+	Reason string            `json:"reason,omitempty"`
+	Tags   map[string]string `json:"tags,omitempty"`
+	Type   string            `json:"type,omitempty"`
+}
+
+// sweepReport is shared by all sweepers run by the current process.
+var sweepReport = newReport()
+
+func newReport() *report {
+	return &report{
+		Regions: make(map[string]*regionReport),
+	}
+}
+
+func (r *report) region(region string) *regionReport {
+	v, ok := r.Regions[region]
+	if !ok {
+		v = &regionReport{
+			Swept:   []reportEntry{},
+			Skipped: []reportEntry{},
+			Failed:  []reportEntry{},
+		}
+		r.Regions[region] = v
+	}
+
+	return v
+}
+
+func (r *report) swept(region string, entry reportEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Swept = append(v.Swept, entry)
+}
+
+func (r *report) skipped(region string, entry reportEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Skipped = append(v.Skipped, entry)
+}
+
+func (r *report) failed(region string, entry reportEntry) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	v := r.region(region)
+	v.Failed = append(v.Failed, entry)
+}
+
+// write replaces the contents of the specified file with the report.
+// The report is rewritten after each sweep so that it is complete however the process exits.
+func (r *report) write(path string, dryRun bool) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	r.DryRun = dryRun
+
+	b, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, b, 0600)
+}
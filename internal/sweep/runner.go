@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/envvar"
+	"github.com/hashicorp/terraform-provider-aws/internal/experimental/depgraph"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+const defaultSweeperConcurrency = 10
+
+// sweepers holds all registered sweepers, keyed by name.
+var sweepers = make(map[string]*resource.Sweeper)
+
+// AddTestSweepers registers a sweeper.
+// A sweeper's dependencies are the sweepers that must run before it.
+func AddTestSweepers(name string, s *resource.Sweeper) {
+	if _, ok := sweepers[name]; ok {
+		log.Fatalf("[ERR] Error adding (%s) to sweepers: sweeper already exists", name)
+	}
+
+	sweepers[name] = s
+}
+
+// TestMain runs the registered sweepers if the -sweep flag is set, otherwise the tests are run.
+// Sweepers are run in dependency order: sweepers whose dependencies have all run are run concurrently,
+// and sweepers that fail are retried once all other sweepers in the region have run.
+//
+// The -sweep, -sweep-allow-failures and -sweep-run flags are those defined by the Plugin Testing framework.
+func TestMain(m interface {
+	Run() int
+}) {
+	flag.Parse()
+
+	regions := splitList(flag.Lookup("sweep").Value.String())
+	if len(regions) == 0 {
+		resource.TestMain(m)
+		return
+	}
+
+	allowFailures := flag.Lookup("sweep-allow-failures").Value.String() == "true"
+	selected := filterSweepers(flag.Lookup("sweep-run").Value.String(), sweepers)
+
+	g, err := sweeperGraph(selected)
+	if err != nil {
+		log.Printf("[ERROR] Invalid sweeper dependencies: %s", err)
+		os.Exit(1)
+	}
+
+	if path := os.Getenv(envvar.SweepGraph); path != "" {
+		if err := writeSweeperGraph(path, g); err != nil {
+			log.Printf("[ERROR] Writing sweeper graph (%s): %s", path, err)
+			os.Exit(1)
+		}
+	}
+
+	concurrency, err := sweeperConcurrency()
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+
+	err = runSweepers(regions, selected, g, allowFailures, concurrency)
+
+	// Resources skipped by sweepers that do not use SweepOrchestrator are only recorded once all sweepers have run.
+	if options, optionsErr := sweepOptions(); optionsErr == nil && options.ReportPath != "" {
+		if reportErr := sweepReport.write(options.ReportPath, options.DryRun); reportErr != nil {
+			log.Printf("[ERROR] Writing sweeper report (%s): %s", options.ReportPath, reportErr)
+			os.Exit(1)
+		}
+	}
+
+	if err != nil {
+		log.Printf("[ERROR] %s", err)
+		os.Exit(1)
+	}
+}
+
+// sweeperConcurrency returns the maximum number of sweepers run concurrently.
+func sweeperConcurrency() (int, error) {
+	v := os.Getenv(envvar.SweepConcurrency)
+	if v == "" {
+		return defaultSweeperConcurrency, nil
+	}
+
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		return 0, fmt.Errorf("environment variable %s: %w", envvar.SweepConcurrency, err)
+	}
+	if n < 1 {
+		return 0, fmt.Errorf("environment variable %s: must be at least 1, got %d", envvar.SweepConcurrency, n)
+	}
+
+	return n, nil
+}
+
+// filterSweepers returns the sweepers whose names contain any of the comma-separated values in f, together with their dependencies.
+// All sweepers are returned if f is empty.
+func filterSweepers(f string, source map[string]*resource.Sweeper) map[string]*resource.Sweeper {
+	filters := splitList(strings.ToLower(f))
+	if len(filters) == 0 {
+		return source
+	}
+
+	result := make(map[string]*resource.Sweeper)
+
+	var add func(name string)
+	add = func(name string) {
+		s, ok := source[name]
+		if !ok {
+			return
+		}
+		if _, ok := result[name]; ok {
+			return
+		}
+
+		result[name] = s
+		for _, dependency := range s.Dependencies {
+			add(dependency)
+		}
+	}
+
+	for name := range source {
+		for _, filter := range filters {
+			if strings.Contains(strings.ToLower(name), filter) {
+				add(name)
+			}
+		}
+	}
+
+	return result
+}
+
+// sweeperGraph returns the dependency graph of the specified sweepers.
+// An error is returned if a sweeper depends on an unknown sweeper or if the dependencies contain a cycle.
+func sweeperGraph(sweepers map[string]*resource.Sweeper) (*depgraph.Graph, error) {
+	g := depgraph.New()
+
+	names := maps.Keys(sweepers)
+	slices.Sort(names)
+
+	for _, name := range names {
+		g.AddNode(name)
+	}
+
+	var errs *multierror.Error
+
+	for _, name := range names {
+		for _, dependency := range sweepers[name].Dependencies {
+			if err := g.AddDependency(name, dependency); err != nil {
+				errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) has dependency (%s), but that sweeper was not found", name, dependency))
+			}
+		}
+	}
+
+	if err := errs.ErrorOrNil(); err != nil {
+		return nil, err
+	}
+
+	if _, err := g.OverallOrder(); err != nil {
+		return nil, err
+	}
+
+	return g, nil
+}
+
+func writeSweeperGraph(path string, g *depgraph.Graph) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	return g.WriteDOT(f, "sweepers")
+}
+
+// runSweepers runs the specified sweepers in each region.
+// Each level of the dependency graph is run concurrently, up to concurrency sweepers at a time, once the previous level has completed.
+// Sweepers that fail, typically because resources swept later still depend on their resources, are retried once after the last level.
+// Unless failures are allowed, sweeping stops after the first region with a failed sweeper.
+func runSweepers(regions []string, sweepers map[string]*resource.Sweeper, g *depgraph.Graph, allowFailures bool, concurrency int) error {
+	levels, err := g.Levels()
+	if err != nil {
+		return err
+	}
+
+	var errs *multierror.Error
+
+	for _, region := range regions {
+		ctx := Context(region)
+
+		start := time.Now()
+		tflog.Info(ctx, "Running sweepers")
+
+		failed := make(map[string]error)
+		for _, level := range levels {
+			maps.Copy(failed, runSweeperLevel(ctx, region, sweepers, level, concurrency))
+		}
+
+		if len(failed) > 0 {
+			tflog.Info(ctx, "Retrying failed sweepers", map[string]any{
+				"sweepers": len(failed),
+			})
+
+			retry := failed
+			failed = make(map[string]error)
+			for _, level := range levels {
+				names := tfslices.Filter(level, func(name string) bool {
+					_, ok := retry[name]
+					return ok
+				})
+
+				maps.Copy(failed, runSweeperLevel(ctx, region, sweepers, names, concurrency))
+			}
+		}
+
+		tflog.Info(ctx, "Completed sweepers", map[string]any{
+			"elapsed": time.Since(start).String(),
+		})
+
+		if len(failed) == 0 {
+			continue
+		}
+
+		names := maps.Keys(failed)
+		slices.Sort(names)
+
+		for _, name := range names {
+			errs = multierror.Append(errs, fmt.Errorf("sweeper (%s) for region (%s) failed: %w", name, region, failed[name]))
+		}
+
+		if !allowFailures {
+			break
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+// runSweeperLevel concurrently runs the named sweepers in the specified region and returns the errors of those that fail.
+// At most concurrency sweepers are run at a time.
+func runSweeperLevel(ctx context.Context, region string, sweepers map[string]*resource.Sweeper, names []string, concurrency int) map[string]error {
+	var wg sync.WaitGroup
+	var lock sync.Mutex
+	failed := make(map[string]error)
+	sem := make(chan struct{}, concurrency)
+
+	for _, name := range names {
+		name := name
+		s := sweepers[name]
+
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			ctx := logWithResourceType(ctx, name)
+			tflog.Debug(ctx, "Running sweeper")
+
+			if err := s.F(region); err != nil {
+				tflog.Warn(ctx, "Sweeper failed", map[string]any{
+					"error": err.Error(),
+				})
+
+				lock.Lock()
+				failed[name] = err
+				lock.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	return failed
+}
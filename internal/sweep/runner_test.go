@@ -0,0 +1,236 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sweep
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"golang.org/x/exp/maps"
+	"golang.org/x/exp/slices"
+)
+
+func TestFilterSweepers(t *testing.T) {
+	t.Parallel()
+
+	source := map[string]*resource.Sweeper{
+		"aws_vpc":             {Name: "aws_vpc", Dependencies: []string{"aws_subnet"}},
+		"aws_subnet":          {Name: "aws_subnet", Dependencies: []string{"aws_instance"}},
+		"aws_instance":        {Name: "aws_instance"},
+		"aws_sqs_queue":       {Name: "aws_sqs_queue"},
+		"aws_iam_role":        {Name: "aws_iam_role", Dependencies: []string{"aws_missing"}},
+		"aws_iam_user_policy": {Name: "aws_iam_user_policy"},
+	}
+
+	testCases := []struct {
+		name     string
+		filter   string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			expected: maps.Keys(source),
+		},
+		{
+			name:     "dependencies",
+			filter:   "aws_vpc",
+			expected: []string{"aws_instance", "aws_subnet", "aws_vpc"},
+		},
+		{
+			name:     "substring",
+			filter:   "IAM,sqs",
+			expected: []string{"aws_iam_role", "aws_iam_user_policy", "aws_sqs_queue"},
+		},
+		{
+			name:   "no match",
+			filter: "aws_s3_bucket",
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := maps.Keys(filterSweepers(testCase.filter, source))
+			slices.Sort(got)
+			expected := slices.Clone(testCase.expected)
+			slices.Sort(expected)
+
+			if diff := cmp.Diff(got, expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSweeperGraph(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		sweepers    map[string]*resource.Sweeper
+		expectError bool
+	}{
+		{
+			name: "valid",
+			sweepers: map[string]*resource.Sweeper{
+				"a": {Name: "a", Dependencies: []string{"b", "c"}},
+				"b": {Name: "b", Dependencies: []string{"c"}},
+				"c": {Name: "c"},
+			},
+		},
+		{
+			name: "unknown dependency",
+			sweepers: map[string]*resource.Sweeper{
+				"a": {Name: "a", Dependencies: []string{"b"}},
+			},
+			expectError: true,
+		},
+		{
+			name: "cycle",
+			sweepers: map[string]*resource.Sweeper{
+				"a": {Name: "a", Dependencies: []string{"b"}},
+				"b": {Name: "b", Dependencies: []string{"c"}},
+				"c": {Name: "c", Dependencies: []string{"a"}},
+			},
+			expectError: true,
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := sweeperGraph(testCase.sweepers)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("sweeperGraph() error = %v, expectError %t", err, want)
+			}
+		})
+	}
+}
+
+func TestRunSweepers(t *testing.T) {
+	t.Parallel()
+
+	var lock sync.Mutex
+	var calls []string
+	attempts := make(map[string]int)
+
+	// "subnet" fails until "instance", which is swept after it, has run.
+	sweeper := func(name string, dependencies ...string) *resource.Sweeper {
+		return &resource.Sweeper{
+			Name:         name,
+			Dependencies: dependencies,
+			F: func(region string) error {
+				lock.Lock()
+				defer lock.Unlock()
+
+				calls = append(calls, name)
+				attempts[name]++
+
+				switch {
+				case name == "subnet" && attempts["instance"] == 0:
+					return errors.New("DependencyViolation")
+				case name == "broken":
+					return errors.New("always fails")
+				}
+
+				return nil
+			},
+		}
+	}
+
+	sweepers := map[string]*resource.Sweeper{
+		"vpc":      sweeper("vpc", "subnet"),
+		"subnet":   sweeper("subnet"),
+		"instance": sweeper("instance", "subnet"),
+		"queue":    sweeper("queue"),
+	}
+
+	g, err := sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if err := runSweepers([]string{"us-west-2"}, sweepers, g, false, 2); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got, want := attempts, map[string]int{"subnet": 2, "instance": 1, "vpc": 1, "queue": 1}; !cmp.Equal(got, want) {
+		t.Errorf("unexpected attempts (+wanted, -got): %s", cmp.Diff(got, want))
+	}
+
+	// The retry happens after the last level.
+	if got, want := calls[len(calls)-1], "subnet"; got != want {
+		t.Errorf("last call = %q, want %q", got, want)
+	}
+
+	sweepers["broken"] = sweeper("broken")
+	g, err = sweeperGraph(sweepers)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	attempts = make(map[string]int)
+	if err := runSweepers([]string{"us-west-2", "us-east-1"}, sweepers, g, false, 2); err == nil {
+		t.Fatal("expected error")
+	}
+
+	// Sweeping stops after the first region with a failed sweeper.
+	if got, want := attempts["broken"], 2; got != want {
+		t.Errorf("broken attempts = %d, want %d", got, want)
+	}
+}
+
+func TestRunSweeperLevelConcurrency(t *testing.T) {
+	t.Parallel()
+
+	const concurrency = 2
+
+	var lock sync.Mutex
+	var running, maxRunning int
+
+	sweepers := make(map[string]*resource.Sweeper)
+	var names []string
+	for i := 0; i < 10; i++ {
+		name := fmt.Sprintf("sweeper%d", i)
+		names = append(names, name)
+		sweepers[name] = &resource.Sweeper{
+			Name: name,
+			F: func(region string) error {
+				lock.Lock()
+				running++
+				maxRunning = max(maxRunning, running)
+				lock.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+
+				lock.Lock()
+				running--
+				lock.Unlock()
+
+				return nil
+			},
+		}
+	}
+
+	if failed := runSweeperLevel(Context("us-west-2"), "us-west-2", sweepers, names, concurrency); len(failed) > 0 {
+		t.Fatalf("unexpected failures: %v", failed)
+	}
+
+	if maxRunning > concurrency {
+		t.Errorf("max concurrent sweepers = %d, want at most %d", maxRunning, concurrency)
+	}
+}
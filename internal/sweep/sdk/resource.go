@@ -16,7 +16,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
 	"golang.org/x/exp/maps"
 )
 
@@ -84,6 +87,58 @@ func (sr *sweepResource) Delete(ctx context.Context, timeout time.Duration, optF
 	return err
 }
 
+// Describe returns the swept resource's ID, name and, if readTags is true, tags.
+// A resource with no name attribute is named by its ID. If readName is true and the resource's name is not known,
+// it is read using the resource's Read function; name is empty if it still cannot be determined.
+// Tags are read using the resource's Read function and are nil if the resource has no tags or they cannot be determined.
+func (sr *sweepResource) Describe(ctx context.Context, readName, readTags bool) (string, string, map[string]string, error) {
+	schema := sr.resource.SchemaMap()
+	id := sr.d.Id()
+
+	name := id
+	_, hasName := schema[names.AttrName]
+	if hasName {
+		name = sr.d.Get(names.AttrName).(string)
+	}
+
+	var key string
+	if readTags {
+		for _, v := range []string{names.AttrTagsAll, names.AttrTags} {
+			if _, ok := schema[v]; ok {
+				key = v
+				break
+			}
+		}
+	}
+
+	if key == "" && (!readName || name != "") {
+		return id, name, nil, nil
+	}
+
+	// Resources using the provider's automatic tag handling return their tags in Context, not in state.
+	ctx = tftags.NewContext(ctx, nil, nil, nil)
+
+	if err := ReadResource(ctx, sr.resource, sr.d, sr.meta); err != nil {
+		return id, name, nil, err
+	}
+
+	if hasName && name == "" {
+		name = sr.d.Get(names.AttrName).(string)
+	}
+
+	var tags map[string]string
+	if key != "" {
+		if inContext, ok := tftags.FromContext(ctx); ok && inContext.TagsOut.IsSome() {
+			tags = inContext.TagsOut.MustUnwrap().Map()
+		} else if v := sr.d.Get(key).(map[string]interface{}); len(v) > 0 {
+			tags = flex.ExpandStringValueMap(v)
+		}
+		// Otherwise an empty tag map in state can't be distinguished from tags that were never read.
+	}
+
+	return id, name, tags, nil
+}
+
 type readerSweepResource struct {
 	sweepResource
 }
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdk
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestSweepResourceDescribeTags(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		read     schema.ReadContextFunc
+		expected map[string]string
+	}{
+		"tags in Context": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				if inContext, ok := tftags.FromContext(ctx); ok {
+					inContext.TagsOut = types.Some(tftags.New(ctx, map[string]string{"Protected": "true"}))
+				}
+				return nil
+			},
+			expected: map[string]string{"Protected": "true"},
+		},
+		"tags in state": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				d.Set(names.AttrTags, map[string]string{"Protected": "true"})
+				return nil
+			},
+			expected: map[string]string{"Protected": "true"},
+		},
+		"tags unknown": {
+			read: func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+				return nil
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &schema.Resource{
+				ReadWithoutTimeout: testCase.read,
+				Schema: map[string]*schema.Schema{
+					names.AttrTags: {
+						Type:     schema.TypeMap,
+						Optional: true,
+						Elem:     &schema.Schema{Type: schema.TypeString},
+					},
+				},
+			}
+			d := r.Data(nil)
+			d.SetId("test")
+
+			_, _, tags, err := NewSweepResource(r, d, nil).Describe(context.Background(), false, true)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if diff := cmp.Diff(tags, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
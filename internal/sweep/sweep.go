@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"sync"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/endpoints"
@@ -35,8 +36,14 @@ var ServicePackages []conns.ServicePackage
 // This prevents client re-initialization for every resource with no benefit.
 var sweeperClients map[string]*conns.AWSClient = make(map[string]*conns.AWSClient)
 
+// sweeperClientsLock serializes access to sweeperClients as sweepers can run concurrently.
+var sweeperClientsLock sync.Mutex
+
 // SharedRegionalSweepClient returns a common conns.AWSClient setup needed for the sweeper functions for a given Region.
 func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSClient, error) {
+	sweeperClientsLock.Lock()
+	defer sweeperClientsLock.Unlock()
+
 	if client, ok := sweeperClients[region]; ok {
 		return client, nil
 	}
@@ -61,7 +68,17 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 	meta.ServicePackages = servicePackageMap
 
+	options, err := sweepOptions()
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &conns.Config{
+		// In dry-run mode any AWS API call that may modify resources is rejected,
+		// including deletions made directly by sweeper functions.
+		// If resources are filtered, only deletions made by SweepOrchestrator are allowed.
+		ReadOnly:         options.DryRun || !options.Filter.IsEmpty(),
+		ReadOnlyExempt:   readOnlyExempt(options, sweepReport, region),
 		Region:           region,
 		SuppressDebugLog: true,
 	}
@@ -99,26 +116,138 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	return client, nil
 }
 
+// readOnlyExempt returns whether an AWS API call that may modify resources is allowed when the sweep client is read-only.
+// Only deletions made by SweepOrchestrator can be filtered, so any other such call is rejected and recorded as skipped.
+func readOnlyExempt(options *Options, r *report, region string) func(ctx context.Context, serviceID, operation string) bool {
+	return func(ctx context.Context, serviceID, operation string) bool {
+		if options.DryRun {
+			return false
+		}
+
+		if isOrchestratorDelete(ctx) {
+			return true
+		}
+
+		entry := reportEntry{
+			Reason: fmt.Sprintf("%s %s called directly by sweeper, which cannot be filtered", serviceID, operation),
+			Type:   resourceTypeFromContext(ctx),
+		}
+		r.skipped(region, entry)
+		tflog.Warn(ctx, "Skipping direct API call", map[string]any{
+			"reason": entry.Reason,
+		})
+
+		return false
+	}
+}
+
 type Sweepable interface {
 	Delete(ctx context.Context, timeout time.Duration, optFns ...tfresource.OptionsFunc) error
 }
 
+// Describable is implemented by Sweepables that can describe the resource they sweep.
+// Only Describable resources can be filtered by name or tags.
+type Describable interface {
+	// Describe returns the resource's ID and name and, if readTags is true, its tags.
+	// A resource with no name attribute is named by its ID. If readName is true, an unknown name is looked up.
+	// name is empty if the resource's name cannot be determined and tags is nil if the resource's tags cannot be determined.
+	Describe(ctx context.Context, readName, readTags bool) (id, name string, tags map[string]string, err error)
+}
+
+// SweepOrchestrator deletes the specified resources concurrently.
+// Resources not allowed by the configured filter are skipped and in dry-run mode no resources are deleted.
 func SweepOrchestrator(ctx context.Context, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
+	options, err := sweepOptions()
+	if err != nil {
+		return err
+	}
+
+	return sweepOrchestrator(ctx, options, sweepReport, sweepables, optFns...)
+}
+
+func sweepOrchestrator(ctx context.Context, options *Options, r *report, sweepables []Sweepable, optFns ...tfresource.OptionsFunc) error {
 	if len(sweepables) == 0 {
 		tflog.Info(ctx, "No resources to sweep")
 	}
 
+	region := regionFromContext(ctx)
+
 	var g multierror.Group
 
 	for _, sweepable := range sweepables {
 		sweepable := sweepable
 
 		g.Go(func() error {
-			return sweepable.Delete(ctx, ThrottlingRetryTimeout, optFns...)
+			entry := reportEntry{
+				Type: resourceTypeFromContext(ctx),
+			}
+			if entry.Type == "" {
+				entry.Type = fmt.Sprintf("%T", sweepable)
+			}
+
+			if v, ok := sweepable.(Describable); ok {
+				id, name, tags, err := v.Describe(ctx, options.Filter.readsNames(), options.Filter.readsTags())
+				entry.ID, entry.Name, entry.Tags = id, name, tags
+
+				if err != nil {
+					entry.Error = err.Error()
+					r.failed(region, entry)
+
+					return fmt.Errorf("describing %s (%s): %w", entry.Type, id, err)
+				}
+
+				if ok, reason := options.Filter.match(name, tags); !ok {
+					entry.Reason = reason
+					r.skipped(region, entry)
+					tflog.Info(ctx, "Skipping resource", map[string]any{
+						"id":     id,
+						"reason": reason,
+					})
+
+					return nil
+				}
+			} else if !options.Filter.IsEmpty() {
+				entry.Reason = "resource cannot be filtered"
+				r.skipped(region, entry)
+				tflog.Info(ctx, "Skipping resource", map[string]any{
+					"reason": entry.Reason,
+				})
+
+				return nil
+			}
+
+			if options.DryRun {
+				r.swept(region, entry)
+				tflog.Info(ctx, "Would sweep resource", map[string]any{
+					"id":   entry.ID,
+					"name": entry.Name,
+				})
+
+				return nil
+			}
+
+			if err := sweepable.Delete(withOrchestratorDelete(ctx), ThrottlingRetryTimeout, optFns...); err != nil {
+				entry.Error = err.Error()
+				r.failed(region, entry)
+
+				return err
+			}
+
+			r.swept(region, entry)
+
+			return nil
 		})
 	}
 
-	return g.Wait().ErrorOrNil()
+	err := g.Wait().ErrorOrNil()
+
+	if options.ReportPath != "" {
+		if reportErr := r.write(options.ReportPath, options.DryRun); reportErr != nil {
+			err = multierror.Append(err, fmt.Errorf("writing sweeper report (%s): %w", options.ReportPath, reportErr))
+		}
+	}
+
+	return err
 }
 
 // Deprecated: Usse awsv1.SkipSweepError
@@ -143,11 +272,12 @@ func PartitionDNSSuffix(region string) string {
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
 func Register(name string, f SweeperFn, dependencies ...string) {
-	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+	AddTestSweepers(name, &resource.Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
-			ctx = logWithResourceType(ctx, name)
+			ctx = withResourceType(ctx, name)
 
 			client, err := SharedRegionalSweepClient(ctx, region)
 			if err != nil {
@@ -32,7 +32,9 @@ const (
 
 // DefaultConfig contains tags to default across all resources.
 type DefaultConfig struct {
-	Tags KeyValueTags
+	// NormalizeKeys, if set, causes resource and default tag keys that differ only in case or whitespace to be treated as the same key.
+	NormalizeKeys bool
+	Tags          KeyValueTags
 }
 
 // IgnoreConfig contains various options for removing resource tags.
@@ -59,12 +61,63 @@ func (dc *DefaultConfig) GetTags() KeyValueTags {
 // MergeTags returns the result of keyvaluetags.Merge() on the given
 // DefaultConfig.Tags with KeyValueTags provided as an argument,
 // overriding the value of any tag with a matching key.
+//
+// If the DefaultConfig normalizes keys, a resource tag also overrides
+// any default tag whose key differs only in case or whitespace.
 func (dc *DefaultConfig) MergeTags(tags KeyValueTags) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
 	}
 
-	return dc.Tags.Merge(tags)
+	if !dc.NormalizeKeys {
+		return dc.Tags.Merge(tags)
+	}
+
+	keys := make(map[string]struct{}, len(tags))
+	for k := range tags {
+		keys[NormalizeKey(k)] = struct{}{}
+	}
+
+	result := make(KeyValueTags)
+
+	for k, v := range dc.Tags {
+		if _, ok := keys[NormalizeKey(k)]; !ok {
+			result[k] = v
+		}
+	}
+
+	for k, v := range tags {
+		result[k] = v
+	}
+
+	return result
+}
+
+// tag returns the default tag with the given key.
+// If the DefaultConfig normalizes keys, the default tag's key may differ in case or whitespace.
+func (dc *DefaultConfig) tag(key string) (*TagData, bool) {
+	if dc == nil {
+		return nil, false
+	}
+
+	if v, ok := dc.Tags[key]; ok || !dc.NormalizeKeys {
+		return v, ok
+	}
+
+	key = NormalizeKey(key)
+	for k, v := range dc.Tags {
+		if NormalizeKey(k) == key {
+			return v, true
+		}
+	}
+
+	return nil, false
+}
+
+// NormalizeKey returns a tag key in lower case with leading and trailing whitespace removed
+// and any other runs of whitespace replaced by a single space.
+func NormalizeKey(key string) string {
+	return strings.ToLower(strings.Join(strings.Fields(key), " "))
 }
 
 // TagsEqual returns true if the given configuration's Tags
@@ -503,6 +556,7 @@ func (tags KeyValueTags) Hash() int {
 // however, if all tags present in the DefaultConfig object are equivalent to those
 // in the given KeyValueTags, then the KeyValueTags are returned, effectively
 // bypassing the need to remove differing tags.
+// If the DefaultConfig normalizes keys, tag keys are compared ignoring case and whitespace.
 func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	if dc == nil || dc.Tags == nil {
 		return tags
@@ -511,7 +565,7 @@ func (tags KeyValueTags) RemoveDefaultConfig(dc *DefaultConfig) KeyValueTags {
 	result := make(KeyValueTags)
 
 	for k, v := range tags {
-		if defaultVal, ok := dc.Tags[k]; !ok || !v.Equal(defaultVal) {
+		if defaultVal, ok := dc.tag(k); !ok || !v.Equal(defaultVal) {
 			result[k] = v
 		}
 	}
@@ -812,7 +866,7 @@ func (tags KeyValueTags) ResolveDuplicates(ctx context.Context, defaultConfig *D
 	for k, v := range configTags {
 		if _, ok := result[k]; !ok {
 			if defaultConfig != nil {
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == v.value {
+				if val, ok := defaultConfig.tag(k); ok && val.ValueString() == v.value {
 					// config does not exist during a refresh.
 					// set duplicate values from other sources for refresh diff calculation
 					if !configExists {
@@ -859,7 +913,7 @@ func (tags KeyValueTags) ResolveDuplicatesFramework(ctx context.Context, default
 					)
 				}
 
-				if val, ok := defaultConfig.Tags[k]; ok && val.ValueString() == s {
+				if val, ok := defaultConfig.tag(k); ok && val.ValueString() == s {
 					result[k] = s
 				}
 			}
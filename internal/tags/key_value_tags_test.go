@@ -190,6 +190,41 @@ func TestKeyValueTagsDefaultConfigMergeTags(t *testing.T) {
 				"key6": "value6",
 			},
 		},
+		{
+			name: "normalized keys",
+			tags: New(ctx, map[string]string{
+				"owner":        "team1",
+				"cost  center": "cc1",
+			}),
+			defaultConfig: &DefaultConfig{
+				NormalizeKeys: true,
+				Tags: New(ctx, map[string]string{
+					"Owner":        "team2",
+					" Cost Center": "cc2",
+					"Environment":  "prod",
+				}),
+			},
+			want: map[string]string{
+				"owner":        "team1",
+				"cost  center": "cc1",
+				"Environment":  "prod",
+			},
+		},
+		{
+			name: "keys not normalized",
+			tags: New(ctx, map[string]string{
+				"owner": "team1",
+			}),
+			defaultConfig: &DefaultConfig{
+				Tags: New(ctx, map[string]string{
+					"Owner": "team2",
+				}),
+			},
+			want: map[string]string{
+				"owner": "team1",
+				"Owner": "team2",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2269,6 +2304,25 @@ func TestKeyValueTagsRemoveDefaultConfig(t *testing.T) {
 				"key3": "value3",
 			},
 		},
+		{
+			name: "normalized keys",
+			tags: New(ctx, map[string]string{
+				"owner":        "team1",
+				"cost  center": "cc1",
+				"environment":  "dev",
+			}),
+			defaultConfig: &DefaultConfig{
+				NormalizeKeys: true,
+				Tags: New(ctx, map[string]string{
+					"Owner":       "team1",
+					"Cost Center": "cc1",
+					"Environment": "prod",
+				}),
+			},
+			want: map[string]string{
+				"environment": "dev",
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -2936,3 +2990,28 @@ func testBoolPtr(b bool) *bool {
 func testStringPtr(str string) *string {
 	return &str
 }
+
+func TestNormalizeKey(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		key  string
+		want string
+	}{
+		{key: "", want: ""},
+		{key: "Owner", want: "owner"},
+		{key: "  Cost\tCenter ", want: "cost center"},
+		{key: "aws:cloudformation:stack-name", want: "aws:cloudformation:stack-name"},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.key, func(t *testing.T) {
+			t.Parallel()
+
+			if got, want := NormalizeKey(testCase.key), testCase.want; got != want {
+				t.Errorf("NormalizeKey(%q) = %q, want %q", testCase.key, got, want)
+			}
+		})
+	}
+}
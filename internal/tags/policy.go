@@ -0,0 +1,69 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+)
+
+// PolicyConfig contains rules that the tags of every resource must satisfy.
+type PolicyConfig struct {
+	// AllowedValues maps a tag key to a regular expression that the tag's value must match.
+	AllowedValues map[string]*regexp.Regexp
+	// ForbiddenKeys are tag keys that must not be present.
+	ForbiddenKeys []string
+	// RequiredKeys are tag keys that must be present.
+	RequiredKeys []string
+}
+
+// Validate returns an error describing every rule violated by the given tags.
+func (pc *PolicyConfig) Validate(tags KeyValueTags) error {
+	if pc == nil {
+		return nil
+	}
+
+	var errs []error
+
+	requiredKeys := append([]string(nil), pc.RequiredKeys...)
+	sort.Strings(requiredKeys)
+	for _, k := range requiredKeys {
+		if _, ok := tags[k]; !ok {
+			errs = append(errs, fmt.Errorf("required tag key %q is missing", k))
+		}
+	}
+
+	forbiddenKeys := append([]string(nil), pc.ForbiddenKeys...)
+	sort.Strings(forbiddenKeys)
+	for _, k := range forbiddenKeys {
+		if _, ok := tags[k]; ok {
+			errs = append(errs, fmt.Errorf("tag key %q is forbidden", k))
+		}
+	}
+
+	keys := make([]string, 0, len(pc.AllowedValues))
+	for k := range pc.AllowedValues {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		v, ok := tags[k]
+		if !ok {
+			continue
+		}
+
+		var value string
+		if v != nil && v.Value != nil {
+			value = *v.Value
+		}
+
+		if re := pc.AllowedValues[k]; re != nil && !re.MatchString(value) {
+			errs = append(errs, fmt.Errorf("tag %q value %q does not match %q", k, value, re.String()))
+		}
+	}
+
+	return errors.Join(errs...)
+}
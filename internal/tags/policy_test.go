@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tags
+
+import (
+	"context"
+	"regexp"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+)
+
+func TestPolicyConfigValidate(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	policy := &PolicyConfig{
+		AllowedValues: map[string]*regexp.Regexp{
+			"env": regexache.MustCompile(`^(dev|prod)$`),
+		},
+		ForbiddenKeys: []string{"temp"},
+		RequiredKeys:  []string{"owner", "env"},
+	}
+
+	testCases := []struct {
+		name    string
+		policy  *PolicyConfig
+		tags    KeyValueTags
+		wantErr string
+	}{
+		{
+			name:   "nil policy",
+			policy: nil,
+			tags:   New(ctx, map[string]string{"temp": "yes"}),
+		},
+		{
+			name:   "compliant",
+			policy: policy,
+			tags:   New(ctx, map[string]string{"env": "prod", "owner": "team1", "other": "value"}),
+		},
+		{
+			name:    "missing required keys",
+			policy:  policy,
+			tags:    New(ctx, map[string]string{}),
+			wantErr: "required tag key \"env\" is missing\nrequired tag key \"owner\" is missing",
+		},
+		{
+			name:    "forbidden key",
+			policy:  policy,
+			tags:    New(ctx, map[string]string{"env": "dev", "owner": "team1", "temp": ""}),
+			wantErr: "tag key \"temp\" is forbidden",
+		},
+		{
+			name:    "value not allowed",
+			policy:  policy,
+			tags:    New(ctx, map[string]string{"env": "staging", "owner": "team1"}),
+			wantErr: "tag \"env\" value \"staging\" does not match \"^(dev|prod)$\"",
+		},
+		{
+			name: "allowed values only",
+			policy: &PolicyConfig{
+				AllowedValues: map[string]*regexp.Regexp{
+					"env": regexache.MustCompile(`^(dev|prod)$`),
+				},
+			},
+			tags: New(ctx, map[string]string{"owner": "team1"}),
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := testCase.policy.Validate(testCase.tags)
+
+			if testCase.wantErr == "" {
+				if err != nil {
+					t.Errorf("unexpected error: %s", err)
+				}
+			} else if err == nil {
+				t.Errorf("expected error %q, got none", testCase.wantErr)
+			} else if got, want := err.Error(), testCase.wantErr; got != want {
+				t.Errorf("got error %q, want %q", got, want)
+			}
+		})
+	}
+}
@@ -5,6 +5,9 @@ package tfresource
 
 import (
 	"errors"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 )
@@ -19,11 +22,18 @@ func NotFound(err error) bool {
 
 // TimedOut returns true if the error represents a "wait timed out" condition.
 // Specifically, TimedOut returns true if the error matches all these conditions:
-//   - err is of type retry.TimeoutError
+//   - err is of type retry.TimeoutError or TimeoutError
 //   - TimeoutError.LastError is nil
 func TimedOut(err error) bool {
-	timeoutErr, ok := err.(*retry.TimeoutError) //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
-	return ok && timeoutErr.LastError == nil
+	switch err := err.(type) { //nolint:errorlint // Explicitly does *not* match wrapped TimeoutErrors
+	case *retry.TimeoutError:
+		return err.LastError == nil
+
+	case *TimeoutError:
+		return err.LastError == nil
+	}
+
+	return false
 }
 
 // SetLastError sets the LastError field on the error if supported.
@@ -35,9 +45,57 @@ func SetLastError(err, lastErr error) {
 			err.LastError = lastErr
 		}
 
+	case *TimeoutError:
+		if err.LastError == nil {
+			err.LastError = lastErr
+		}
+
 	case *retry.UnexpectedStateError:
 		if err.LastError == nil {
 			err.LastError = lastErr
 		}
 	}
 }
+
+// TimeoutError is returned by StateChangeConf.WaitForStateContext when the wait times out.
+// It records the last observed state and the reason for that state, if known.
+type TimeoutError struct {
+	LastError        error
+	LastState        string
+	LastStatusReason string
+	ExpectedState    []string
+	Timeout          time.Duration
+}
+
+func (e *TimeoutError) Error() string {
+	expectedState := "resource to be gone"
+	if len(e.ExpectedState) > 0 {
+		expectedState = fmt.Sprintf("state to become '%s'", strings.Join(e.ExpectedState, ", "))
+	}
+
+	extraInfo := make([]string, 0)
+	if e.LastState != "" {
+		extraInfo = append(extraInfo, fmt.Sprintf("last state: '%s'", e.LastState))
+	}
+	if e.LastStatusReason != "" {
+		extraInfo = append(extraInfo, fmt.Sprintf("last status reason: '%s'", e.LastStatusReason))
+	}
+	if e.Timeout > 0 {
+		extraInfo = append(extraInfo, fmt.Sprintf("timeout: %s", e.Timeout.String()))
+	}
+
+	suffix := ""
+	if len(extraInfo) > 0 {
+		suffix = fmt.Sprintf(" (%s)", strings.Join(extraInfo, ", "))
+	}
+
+	if e.LastError != nil {
+		return fmt.Sprintf("timeout while waiting for %s%s: %s", expectedState, suffix, e.LastError)
+	}
+
+	return fmt.Sprintf("timeout while waiting for %s%s", expectedState, suffix)
+}
+
+func (e *TimeoutError) Unwrap() error {
+	return e.LastError
+}
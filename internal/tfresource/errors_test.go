@@ -84,6 +84,15 @@ func TestTimedOut(t *testing.T) {
 			Name: "timeout error non-nil last error",
 			Err:  &retry.TimeoutError{LastError: errors.New("test")},
 		},
+		{
+			Name:     "waiter timeout error",
+			Err:      &tfresource.TimeoutError{LastState: "modifying"},
+			Expected: true,
+		},
+		{
+			Name: "waiter timeout error non-nil last error",
+			Err:  &tfresource.TimeoutError{LastError: errors.New("test")},
+		},
 		{
 			Name: "wrapped other error",
 			Err:  fmt.Errorf("test: %w", errors.New("test")),
@@ -148,6 +157,17 @@ func TestSetLastError(t *testing.T) {
 			Err:     &retry.TimeoutError{LastError: errors.New("test")},
 			LastErr: errors.New("lasttest"),
 		},
+		{
+			Name:     "waiter timeout error",
+			Err:      &tfresource.TimeoutError{},
+			LastErr:  errors.New("lasttest"),
+			Expected: true,
+		},
+		{
+			Name:    "waiter timeout error non-nil last error no overwrite",
+			Err:     &tfresource.TimeoutError{LastError: errors.New("test")},
+			LastErr: errors.New("lasttest"),
+		},
 		{
 			Name: "unexpected state error lastErr is nil",
 			Err:  &retry.UnexpectedStateError{},
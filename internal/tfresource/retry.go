@@ -215,6 +215,29 @@ func (o Options) Apply(c *retry.StateChangeConf) {
 	}
 }
 
+// ApplyTo applies the options to a tfresource.StateChangeConf.
+func (o Options) ApplyTo(c *StateChangeConf) {
+	if o.Delay > 0 {
+		c.Delay = o.Delay
+	}
+
+	if o.MinPollInterval > 0 {
+		c.MinPollInterval = o.MinPollInterval
+	}
+
+	if o.PollInterval > 0 {
+		c.PollInterval = o.PollInterval
+	}
+
+	if o.NotFoundChecks > 0 {
+		c.NotFoundChecks = o.NotFoundChecks
+	}
+
+	if o.ContinuousTargetOccurence > 0 {
+		c.ContinuousTargetOccurence = o.ContinuousTargetOccurence
+	}
+}
+
 type OptionsFunc func(*Options)
 
 func WithDelay(delay time.Duration) OptionsFunc {
@@ -5,9 +5,15 @@ package tfresource
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	tfretry "github.com/hashicorp/terraform-provider-aws/internal/retry"
 )
 
 type WaitOpts struct {
@@ -26,7 +32,8 @@ const (
 // WaitUntil waits for the function `f` to return `true`.
 // If `f` returns an error, return immediately with that error.
 // If `timeout` is exceeded before `f` returns `true`, return an error.
-// Waits between calls to `f` using exponential backoff, except when waiting for the target state to reoccur.
+// Waits between calls to `f` using jittered exponential backoff.
+// Progress is logged at warning level while waiting.
 func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error), opts WaitOpts) error {
 	refresh := func() (interface{}, string, error) {
 		done, err := f()
@@ -42,14 +49,14 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 		return "", targetStateFalse, nil
 	}
 
-	stateConf := &retry.StateChangeConf{
+	stateConf := &StateChangeConf{
 		Pending:                   []string{targetStateFalse},
 		Target:                    []string{targetStateTrue},
 		Refresh:                   refresh,
 		Timeout:                   timeout,
 		ContinuousTargetOccurence: opts.ContinuousTargetOccurence,
 		Delay:                     opts.Delay,
-		MinTimeout:                opts.MinTimeout,
+		MinPollInterval:           opts.MinTimeout,
 		PollInterval:              opts.PollInterval,
 	}
 
@@ -57,3 +64,199 @@ func WaitUntil(ctx context.Context, timeout time.Duration, f func() (bool, error
 
 	return err
 }
+
+// StateChangeConf is the configuration for waiting on a resource to reach a target state.
+// It is a replacement for the Plugin SDK's retry.StateChangeConf that periodically logs progress at warning level,
+// so that long-running operations can be followed in the provider log (e.g. TF_LOG=WARN), and uses jittered exponential backoff between refreshes.
+// Showing progress in Terraform's UI is not possible: the plugin protocol returns diagnostics only when an operation completes.
+type StateChangeConf struct {
+	Operation                 string                 // Operation being waited on, e.g. "creating". Used in progress log messages.
+	Pending                   []string               // States that are "allowed" and will continue trying
+	Target                    []string               // Target state
+	Refresh                   retry.StateRefreshFunc // Refreshes the current state
+	StatusReason              func(any) string       // Optionally returns the reason for the current state of the object returned by Refresh
+	Timeout                   time.Duration          // The amount of time to wait before timeout
+	Delay                     time.Duration          // Wait this time before starting checks
+	MinPollInterval           time.Duration          // Smallest time to wait before refreshes
+	MaxPollInterval           time.Duration          // Largest time to wait before refreshes
+	PollInterval              time.Duration          // Override MinPollInterval/MaxPollInterval and only poll this often
+	NotFoundChecks            int                    // Number of times to allow not found (nil result from Refresh)
+	ContinuousTargetOccurence int                    // Number of times the Target state has to occur continuously
+	ProgressLogInterval       time.Duration          // How often to log progress
+}
+
+const (
+	defaultNotFoundChecks      = 20
+	defaultMinPollInterval     = 100 * time.Millisecond
+	defaultMaxPollInterval     = 10 * time.Second
+	defaultProgressLogInterval = 5 * time.Minute
+)
+
+// WaitForStateContext watches an object and waits for it to achieve one of the target states.
+// The returned error is a *TimeoutError if the timeout elapses, a *retry.UnexpectedStateError if the
+// object reaches a state that is neither pending nor target, or a *retry.NotFoundError if the object
+// is not found more than NotFoundChecks times in a row while waiting for a target state.
+func (conf *StateChangeConf) WaitForStateContext(ctx context.Context) (any, error) {
+	notFoundChecks := conf.NotFoundChecks
+	if notFoundChecks <= 0 {
+		notFoundChecks = defaultNotFoundChecks
+	}
+	continuousTargetOccurence := conf.ContinuousTargetOccurence
+	if continuousTargetOccurence <= 0 {
+		continuousTargetOccurence = 1
+	}
+	progressLogInterval := conf.ProgressLogInterval
+	if progressLogInterval <= 0 {
+		progressLogInterval = defaultProgressLogInterval
+	}
+	operation := conf.Operation
+	if operation == "" {
+		operation = "waiting"
+	}
+
+	backoff := tfretry.Options{
+		BackoffMinDuration: max(conf.MinPollInterval, defaultMinPollInterval),
+		BackoffMaxDuration: max(conf.MaxPollInterval, conf.MinPollInterval, defaultMaxPollInterval),
+		BackoffMultiplier:  2,
+	}
+	if conf.PollInterval > 0 {
+		backoff = tfretry.Options{
+			BackoffMinDuration: conf.PollInterval,
+			BackoffMultiplier:  1,
+		}
+	}
+
+	parentCtx := ctx
+	ctx, cancel := context.WithTimeout(ctx, conf.Timeout)
+	defer cancel()
+
+	if conf.Delay > 0 {
+		timer := time.NewTimer(conf.Delay)
+		select {
+		case <-ctx.Done():
+		case <-timer.C:
+		}
+		timer.Stop()
+	}
+
+	start := time.Now()
+	lastProgress := start
+
+	var (
+		result          any
+		state, reason   string
+		notFoundTick    int
+		targetOccurence int
+	)
+	for r := tfretry.BeginWithOptions(backoff); r.Continue(ctx); {
+		res, currentState, err := conf.Refresh()
+
+		if err != nil {
+			return res, err
+		}
+
+		result = res
+
+		if res == nil {
+			// If we're waiting for the absence of a thing, then return.
+			if len(conf.Target) == 0 {
+				targetOccurence++
+				if targetOccurence >= continuousTargetOccurence {
+					return nil, nil
+				}
+				continue
+			}
+
+			targetOccurence = 0
+			notFoundTick++
+			if notFoundTick > notFoundChecks {
+				return nil, &retry.NotFoundError{
+					LastError: statusReasonError(reason),
+					Retries:   notFoundTick,
+				}
+			}
+			continue
+		}
+
+		notFoundTick = 0
+		state = currentState
+		reason = ""
+		if conf.StatusReason != nil {
+			reason = conf.StatusReason(res)
+		}
+
+		if slices.Contains(conf.Target, state) {
+			targetOccurence++
+			if targetOccurence >= continuousTargetOccurence {
+				return res, nil
+			}
+			continue
+		}
+
+		targetOccurence = 0
+
+		if !slices.Contains(conf.Pending, state) {
+			return res, &retry.UnexpectedStateError{
+				LastError:     statusReasonError(reason),
+				State:         state,
+				ExpectedState: conf.Target,
+			}
+		}
+
+		if now := time.Now(); now.Sub(lastProgress) >= progressLogInterval {
+			tflog.Warn(ctx, progressLogMessage(operation, state, reason, now.Sub(start)), map[string]any{
+				"status":        state,
+				"status_reason": reason,
+				"elapsed":       now.Sub(start).String(),
+			})
+			lastProgress = now
+		}
+	}
+
+	if err := parentCtx.Err(); err != nil {
+		return result, err
+	}
+
+	return result, &TimeoutError{
+		LastState:        state,
+		LastStatusReason: reason,
+		ExpectedState:    conf.Target,
+		Timeout:          conf.Timeout,
+	}
+}
+
+// progressLogMessage returns a log message such as "still creating: status=modifying, 12m elapsed".
+func progressLogMessage(operation, state, reason string, elapsed time.Duration) string {
+	var sb strings.Builder
+
+	fmt.Fprintf(&sb, "still %s: status=%s", operation, state)
+	if reason != "" {
+		fmt.Fprintf(&sb, " (%s)", reason)
+	}
+	fmt.Fprintf(&sb, ", %s elapsed", formatElapsed(elapsed))
+
+	return sb.String()
+}
+
+// formatElapsed formats a duration to minute granularity, e.g. "1h5m", or to second granularity below a minute.
+func formatElapsed(d time.Duration) string {
+	if d < time.Minute {
+		return d.Truncate(time.Second).String()
+	}
+
+	s := d.Truncate(time.Minute).String()
+	s = strings.TrimSuffix(s, "0s")
+	if strings.HasSuffix(s, "h0m") {
+		s = strings.TrimSuffix(s, "0m")
+	}
+
+	return s
+}
+
+func statusReasonError(reason string) error {
+	if reason == "" {
+		return nil
+	}
+
+	return errors.New(reason)
+}
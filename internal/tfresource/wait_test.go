@@ -9,6 +9,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 )
@@ -70,3 +71,84 @@ func TestWaitUntil(t *testing.T) { //nolint:tparallel
 		})
 	}
 }
+
+func TestStateChangeConfWaitForStateContext(t *testing.T) { //nolint:tparallel
+	ctx := acctest.Context(t)
+	t.Parallel()
+
+	type object struct {
+		status, reason string
+	}
+
+	testCases := []struct {
+		Name          string
+		States        []string
+		Target        []string
+		ExpectedError func(error) bool
+	}{
+		{
+			Name:   "reaches target",
+			States: []string{"creating", "creating", "available"},
+			Target: []string{"available"},
+		},
+		{
+			Name:   "gone",
+			States: []string{"deleting", ""},
+		},
+		{
+			Name:   "unexpected state",
+			States: []string{"creating", "failed"},
+			Target: []string{"available"},
+			ExpectedError: func(err error) bool {
+				var e *retry.UnexpectedStateError
+				return errors.As(err, &e) && e.State == "failed" && e.LastError != nil && e.LastError.Error() == "reason for failed"
+			},
+		},
+		{
+			Name:   "timeout",
+			States: []string{"creating", "modifying"},
+			Target: []string{"available"},
+			ExpectedError: func(err error) bool {
+				var e *tfresource.TimeoutError
+				return errors.As(err, &e) && tfresource.TimedOut(err) && e.LastState == "modifying" && e.LastStatusReason == "reason for modifying"
+			},
+		},
+	}
+
+	for _, testCase := range testCases { //nolint:paralleltest
+		t.Run(testCase.Name, func(t *testing.T) {
+			var i int
+			stateConf := &tfresource.StateChangeConf{
+				Operation: "testing",
+				Pending:   []string{"creating", "deleting", "modifying"},
+				Target:    testCase.Target,
+				Refresh: func() (interface{}, string, error) {
+					status := testCase.States[min(i, len(testCase.States)-1)]
+					i++
+
+					if status == "" {
+						return nil, "", nil
+					}
+
+					return &object{status: status, reason: "reason for " + status}, status, nil
+				},
+				StatusReason: func(v any) string {
+					return v.(*object).reason
+				},
+				Timeout:             2 * time.Second,
+				PollInterval:        10 * time.Millisecond,
+				ProgressLogInterval: 100 * time.Millisecond,
+			}
+
+			_, err := stateConf.WaitForStateContext(ctx)
+
+			if testCase.ExpectedError == nil {
+				if err != nil {
+					t.Fatalf("unexpected error: %s", err)
+				}
+			} else if !testCase.ExpectedError(err) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ package types
 
 import (
 	"fmt"
+	"math/big"
 	"net"
 )
 
@@ -52,3 +53,100 @@ func CanonicalCIDRBlock(cidr string) string {
 
 	return ipnet.String()
 }
+
+// CIDRSubnet returns the netNum'th CIDR block with a prefix length of newBits more than the specified CIDR block,
+// in the same way as Terraform's cidrsubnet() function.
+func CIDRSubnet(cidr string, newBits int, netNum int64) (string, error) {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return "", fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	ones, bits := ipnet.Mask.Size()
+	if newBits < 0 || ones+newBits > bits {
+		return "", fmt.Errorf("insufficient address space to extend prefix of %d by %d", ones, newBits)
+	}
+	if netNum < 0 || big.NewInt(netNum).Cmp(new(big.Int).Lsh(big.NewInt(1), uint(newBits))) >= 0 {
+		return "", fmt.Errorf("prefix extension of %d does not accommodate a subnet numbered %d", newBits, netNum)
+	}
+
+	start := new(big.Int).SetBytes(ipnet.IP)
+	start.Add(start, new(big.Int).Lsh(big.NewInt(netNum), uint(bits-ones-newBits)))
+
+	return cidrBlockString(start, ones+newBits, bits), nil
+}
+
+// CIDRAllocator allocates non-overlapping CIDR blocks from within a parent CIDR block.
+type CIDRAllocator struct {
+	start, end *big.Int // The parent CIDR block's address range, [start, end).
+	ones, bits int
+	allocated  []addressRange
+}
+
+type addressRange struct {
+	start, end *big.Int // [start, end)
+}
+
+// NewCIDRAllocator returns a CIDRAllocator for the specified parent CIDR block.
+func NewCIDRAllocator(cidr string) (*CIDRAllocator, error) {
+	_, ipnet, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil, fmt.Errorf("%q is not a valid CIDR block: %w", cidr, err)
+	}
+
+	ones, bits := ipnet.Mask.Size()
+	start := new(big.Int).SetBytes(ipnet.IP)
+	end := new(big.Int).Add(start, new(big.Int).Lsh(big.NewInt(1), uint(bits-ones)))
+
+	return &CIDRAllocator{
+		start: start,
+		end:   end,
+		ones:  ones,
+		bits:  bits,
+	}, nil
+}
+
+// Allocate returns the lowest-addressed unallocated CIDR block with the specified prefix length.
+func (a *CIDRAllocator) Allocate(prefixLength int) (string, error) {
+	if prefixLength < a.ones || prefixLength > a.bits {
+		return "", fmt.Errorf("prefix length %d is outside the range /%d to /%d", prefixLength, a.ones, a.bits)
+	}
+
+	size := new(big.Int).Lsh(big.NewInt(1), uint(a.bits-prefixLength))
+	candidate := addressRange{start: new(big.Int).Set(a.start)}
+
+	for {
+		candidate.end = new(big.Int).Add(candidate.start, size)
+		if candidate.end.Cmp(a.end) > 0 {
+			return "", fmt.Errorf("no unallocated /%d CIDR block remains", prefixLength)
+		}
+
+		overlap := -1
+		for i, v := range a.allocated {
+			if v.start.Cmp(candidate.end) < 0 && candidate.start.Cmp(v.end) < 0 {
+				overlap = i
+				break
+			}
+		}
+
+		if overlap == -1 {
+			a.allocated = append(a.allocated, candidate)
+
+			return cidrBlockString(candidate.start, prefixLength, a.bits), nil
+		}
+
+		// Move past the overlapping block, keeping the candidate aligned on its size.
+		next := new(big.Int).Sub(a.allocated[overlap].end, a.start)
+		next.Add(next, new(big.Int).Sub(size, big.NewInt(1)))
+		next.Div(next, size)
+		next.Mul(next, size)
+		candidate.start = next.Add(next, a.start)
+	}
+}
+
+func cidrBlockString(address *big.Int, prefixLength, bits int) string {
+	ip := make(net.IP, bits/8)
+	address.FillBytes(ip)
+
+	return (&net.IPNet{IP: ip, Mask: net.CIDRMask(prefixLength, bits)}).String()
+}
@@ -72,3 +72,76 @@ func TestCanonicalCIDRBlock(t *testing.T) {
 		}
 	}
 }
+
+func TestCIDRSubnet(t *testing.T) {
+	t.Parallel()
+
+	for _, ts := range []struct {
+		cidr    string
+		newBits int
+		netNum  int64
+		want    string
+		wantErr bool
+	}{
+		{"10.0.0.0/16", 8, 0, "10.0.0.0/24", false},
+		{"10.0.0.0/16", 8, 255, "10.0.255.0/24", false},
+		{"10.0.0.0/16", 8, 256, "", true},
+		{"10.0.0.0/30", 4, 0, "", true},
+		{"2600:1f14:abc:de00::/56", 8, 0, "2600:1f14:abc:de00::/64", false},
+		{"2600:1f14:abc:de00::/56", 8, 17, "2600:1f14:abc:de11::/64", false},
+		{"not-a-cidr", 8, 0, "", true},
+	} {
+		got, err := CIDRSubnet(ts.cidr, ts.newBits, ts.netNum)
+		if ts.wantErr {
+			if err == nil {
+				t.Errorf("CIDRSubnet(%q, %d, %d) should error but didn't", ts.cidr, ts.newBits, ts.netNum)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("CIDRSubnet(%q, %d, %d) got unexpected error: %s", ts.cidr, ts.newBits, ts.netNum, err)
+			continue
+		}
+		if got != ts.want {
+			t.Errorf("CIDRSubnet(%q, %d, %d) = %q, want %q", ts.cidr, ts.newBits, ts.netNum, got, ts.want)
+		}
+	}
+}
+
+func TestCIDRAllocator(t *testing.T) {
+	t.Parallel()
+
+	a, err := NewCIDRAllocator("10.0.0.0/22")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	for _, ts := range []struct {
+		prefixLength int
+		want         string
+		wantErr      bool
+	}{
+		{25, "10.0.0.0/25", false},
+		{24, "10.0.1.0/24", false},
+		{26, "10.0.0.128/26", false},
+		{23, "10.0.2.0/23", false},
+		{26, "10.0.0.192/26", false},
+		{26, "", true},
+		{21, "", true},
+	} {
+		got, err := a.Allocate(ts.prefixLength)
+		if ts.wantErr {
+			if err == nil {
+				t.Errorf("Allocate(%d) should error but didn't", ts.prefixLength)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Allocate(%d) got unexpected error: %s", ts.prefixLength, err)
+			continue
+		}
+		if got != ts.want {
+			t.Errorf("Allocate(%d) = %q, want %q", ts.prefixLength, got, ts.want)
+		}
+	}
+}
@@ -16,12 +16,19 @@ var (
 	v1            bool
 	pluginSDKV2   bool
 	includeTags   bool
+	sdkService    string
+	sdkInput      string
+	sdkOutput     string
 )
 
 var resourceCmd = &cobra.Command{
 	Use:   "resource",
 	Short: "Create scaffolding for a resource",
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if sdkInput != "" {
+			return resource.CreateAutoFlex(name, snakeName, sdkService, sdkInput, sdkOutput, !clearComments, force, includeTags)
+		}
+
 		return resource.Create(name, snakeName, !clearComments, force, !v1, !pluginSDKV2, includeTags)
 	},
 }
@@ -35,4 +42,7 @@ func init() {
 	resourceCmd.Flags().BoolVarP(&v1, "v1", "o", false, "generate for AWS Go SDK v1 (some existing services)")
 	resourceCmd.Flags().BoolVarP(&pluginSDKV2, "plugin-sdkv2", "p", false, "generate for Terraform Plugin SDK V2")
 	resourceCmd.Flags().BoolVarP(&includeTags, "include-tags", "t", false, "Indicate that this resource has tags and the code for tagging should be generated")
+	resourceCmd.Flags().StringVar(&sdkService, "sdk-service", "", "AWS SDK for Go v2 service package of the input and output structs (default is the service's package)")
+	resourceCmd.Flags().StringVar(&sdkInput, "sdk-input", "", "AWS SDK for Go v2 create operation input struct (e.g., CreateBrokerInput); generates a Plugin Framework resource using AutoFlex")
+	resourceCmd.Flags().StringVar(&sdkOutput, "sdk-output", "", "AWS SDK for Go v2 types struct describing the resource (default is the name of the entity)")
 }
@@ -0,0 +1,836 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"io/fs"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+)
+
+const (
+	sdkV2ServiceImportPathPrefix = "github.com/aws/aws-sdk-go-v2/service/"
+	sdkRequiredMemberComment     = "This member is required."
+)
+
+// AutoFlexData describes a Plugin Framework resource whose model is derived from AWS SDK for Go v2 structs.
+type AutoFlexData struct {
+	SDKService string
+	Input      string
+	Output     string
+
+	// Model is the resource's model. Nested models are in dependency order.
+	Model        *AutoFlexModel
+	NestedModels []*AutoFlexModel
+	Unsupported  []string
+
+	// IDField is the SDK field used as the resource's ID.
+	// SeparateID is true if the resource's id attribute is in addition to the attribute for IDField.
+	IDField    string
+	IDGoName   string
+	IDAttr     string
+	SeparateID bool
+
+	// TagsIdentifierAttr is the attribute used to list and update tags, preferably the resource's ARN.
+	TagsIdentifierAttr string
+	OutputHasTags      bool
+
+	// NameAttr is the required argument that names the resource, if any.
+	NameAttr string
+
+	CreateOp          string
+	CreateOutputField string
+	ReadOp            string
+	ReadInput         string
+	ReadInputField    string
+	ReadOutputField   string
+	UpdateOp          string
+	DeleteOp          string
+	ListOp            string
+	ListOutputField   string
+	ListItemIDField   string
+	ListPaginated     bool
+	NotFoundException string
+	TokenField        string
+	FindResultType    string
+}
+
+// AutoFlexModel is a resource or nested block model struct.
+type AutoFlexModel struct {
+	Name   string
+	Fields []*AutoFlexField
+}
+
+// AutoFlexField is a model struct field and its schema attribute or block.
+// Nested objects are blocks if they are arguments and list attributes if they are computed.
+type AutoFlexField struct {
+	GoName    string
+	TFName    string
+	GoType    string
+	Schema    string
+	Nested    *AutoFlexModel
+	MaxOne    bool
+	Enum      string
+	Timestamp bool
+	Required  bool
+	Optional  bool
+	Computed  bool
+	Replace   bool
+}
+
+// Block returns whether the field is a nested block.
+func (f *AutoFlexField) Block() bool {
+	return f.Schema == "ListNestedBlock"
+}
+
+// PlanModifier returns the plan modifier package prefix for the field's schema type, e.g. "string".
+func (f *AutoFlexField) PlanModifier() string {
+	return strings.ToLower(f.PlanModifierType())
+}
+
+// PlanModifierType returns the plan modifier interface for the field's schema type, e.g. "String".
+func (f *AutoFlexField) PlanModifierType() string {
+	if f.Nested != nil {
+		return "List"
+	}
+
+	return strings.TrimSuffix(f.Schema, "Attribute")
+}
+
+// sdkPackage holds the parsed declarations of an AWS SDK for Go v2 service package and its types package.
+type sdkPackage struct {
+	structs    map[string]*ast.StructType
+	typeStruct map[string]*ast.StructType
+	typeKinds  map[string]string
+	operations map[string]bool
+	functions  map[string]bool
+}
+
+func loadSDKPackage(sdkService, srcDir string) (*sdkPackage, error) {
+	importPath := sdkV2ServiceImportPathPrefix + sdkService
+
+	bp, err := build.Import(importPath, srcDir, build.FindOnly)
+	if err != nil {
+		return nil, fmt.Errorf("finding AWS SDK for Go v2 package (%s): %w", importPath, err)
+	}
+
+	pkg := &sdkPackage{
+		structs:    make(map[string]*ast.StructType),
+		typeStruct: make(map[string]*ast.StructType),
+		typeKinds:  make(map[string]string),
+		operations: make(map[string]bool),
+		functions:  make(map[string]bool),
+	}
+
+	files, err := parseDir(bp.Dir)
+	if err != nil {
+		return nil, err
+	}
+	for name, file := range files {
+		if v, ok := strings.CutPrefix(name, "api_op_"); ok {
+			pkg.operations[strings.TrimSuffix(v, ".go")] = true
+		}
+		for _, spec := range typeSpecs(file) {
+			if v, ok := spec.Type.(*ast.StructType); ok {
+				pkg.structs[spec.Name.Name] = v
+			}
+		}
+		for _, decl := range file.Decls {
+			if v, ok := decl.(*ast.FuncDecl); ok && v.Recv == nil {
+				pkg.functions[v.Name.Name] = true
+			}
+		}
+	}
+
+	files, err = parseDir(bp.Dir + "/types")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		for _, spec := range typeSpecs(file) {
+			switch v := spec.Type.(type) {
+			case *ast.StructType:
+				pkg.typeStruct[spec.Name.Name] = v
+				pkg.typeKinds[spec.Name.Name] = "struct"
+			case *ast.InterfaceType:
+				pkg.typeKinds[spec.Name.Name] = "interface"
+			case *ast.Ident:
+				pkg.typeKinds[spec.Name.Name] = v.Name
+			}
+		}
+	}
+
+	return pkg, nil
+}
+
+func parseDir(dir string) (map[string]*ast.File, error) {
+	fset := token.NewFileSet()
+	filter := func(fi fs.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}
+
+	pkgs, err := parser.ParseDir(fset, dir, filter, parser.ParseComments)
+	if err != nil {
+		return nil, fmt.Errorf("parsing (%s): %w", dir, err)
+	}
+
+	files := make(map[string]*ast.File)
+	for _, pkg := range pkgs {
+		for path, file := range pkg.Files {
+			files[path[strings.LastIndex(path, "/")+1:]] = file
+		}
+	}
+
+	return files, nil
+}
+
+func typeSpecs(file *ast.File) []*ast.TypeSpec {
+	var specs []*ast.TypeSpec
+
+	for _, decl := range file.Decls {
+		if v, ok := decl.(*ast.GenDecl); ok && v.Tok == token.TYPE {
+			for _, spec := range v.Specs {
+				specs = append(specs, spec.(*ast.TypeSpec))
+			}
+		}
+	}
+
+	return specs
+}
+
+// NewAutoFlexData derives a resource model, schema and CRUD operations from the specified AWS SDK for Go v2 input and output structs.
+// The input struct is in the service package, e.g. CreateBrokerInput, and the output struct is in its types package, e.g. Broker.
+// If tags is true, the model includes tags and tags_all.
+func NewAutoFlexData(sdkService, input, output, resName, srcDir string, tags bool) (*AutoFlexData, error) {
+	pkg, err := loadSDKPackage(sdkService, srcDir)
+	if err != nil {
+		return nil, err
+	}
+
+	inputStruct, ok := pkg.structs[input]
+	if !ok {
+		return nil, fmt.Errorf("input struct (%s) not found in AWS SDK for Go v2 package (%s)", input, sdkService)
+	}
+	outputStruct, ok := pkg.typeStruct[output]
+	if !ok {
+		return nil, fmt.Errorf("output struct (%s) not found in AWS SDK for Go v2 package (%s/types)", output, sdkService)
+	}
+
+	data := &AutoFlexData{
+		SDKService: sdkService,
+		Input:      input,
+		Output:     output,
+		CreateOp:   strings.TrimSuffix(input, "Input"),
+	}
+
+	b := &modelBuilder{
+		pkg:     pkg,
+		data:    data,
+		visited: make(map[string]bool),
+	}
+
+	// Arguments come from the input struct and attributes from the output struct.
+	fields := make(map[string]*AutoFlexField)
+	for _, field := range structFields(inputStruct) {
+		if skipField(field.name) {
+			continue
+		}
+		if field.name == "ClientToken" || field.name == "ClientRequestToken" {
+			data.TokenField = field.name
+			continue
+		}
+		if f := b.field(field, "", true); f != nil {
+			fields[f.GoName] = f
+		}
+	}
+	for _, field := range structFields(outputStruct) {
+		if skipField(field.name) {
+			continue
+		}
+		if f := b.field(field, "", false); f != nil {
+			if _, ok := fields[f.GoName]; !ok {
+				fields[f.GoName] = f
+			}
+		}
+	}
+
+	for _, v := range []string{"Describe", "Get"} {
+		if op := v + resName; pkg.operations[op] {
+			data.ReadOp = op
+			data.ReadInput = op + "Input"
+			data.ReadOutputField = outputField(pkg.structs[op+"Output"], output)
+			for _, field := range structFields(pkg.structs[data.ReadInput]) {
+				if field.required {
+					data.ReadInputField = field.name
+					break
+				}
+			}
+			break
+		}
+	}
+
+	// The resource is identified by the field that the read operation requires, if the model has it.
+	data.IDField = data.ReadInputField
+	if f, ok := fields[goFieldName(data.IDField)]; !ok || f.Nested != nil {
+		data.IDField = identifierField(resName, fields)
+	}
+	if data.IDField == "" {
+		return nil, fmt.Errorf("no identifier field (e.g., %sId, Id, %sArn or Arn) found in (%s) or (%s)", resName, resName, input, output)
+	}
+	data.IDGoName = goFieldName(data.IDField)
+	data.IDAttr = fields[data.IDGoName].TFName
+	data.SeparateID = data.IDAttr != "id"
+
+	if f := fields[data.IDGoName]; !data.SeparateID && f.Computed {
+		f.Schema = "ID"
+	}
+	if data.SeparateID {
+		fields["ID"] = &AutoFlexField{GoName: "ID", TFName: "id", GoType: "types.String", Schema: "ID", Computed: true}
+	}
+
+	for _, v := range []string{resName + "Name", "Name"} {
+		if f, ok := fields[v]; ok && f.Required && f.Schema == "StringAttribute" && f.Enum == "" && !f.Timestamp {
+			data.NameAttr = f.TFName
+			break
+		}
+	}
+
+	data.TagsIdentifierAttr = data.IDAttr
+	for _, v := range []string{resName + "Arn", "Arn"} {
+		if f, ok := fields[goFieldName(v)]; ok && f.Nested == nil {
+			data.TagsIdentifierAttr = f.TFName
+			break
+		}
+	}
+
+	if tags {
+		fields["Tags"] = &AutoFlexField{GoName: "Tags", TFName: "tags", GoType: "types.Map", Schema: "Tags", Optional: true}
+		fields["TagsAll"] = &AutoFlexField{GoName: "TagsAll", TFName: "tags_all", GoType: "types.Map", Schema: "TagsAll", Computed: true}
+
+		for _, field := range structFields(outputStruct) {
+			if field.name == "Tags" && field.typ == "map[string]string" {
+				data.OutputHasTags = true
+			}
+		}
+	}
+
+	data.Model = &AutoFlexModel{
+		Name: "resource" + resName + "Data",
+	}
+	for _, f := range fields {
+		data.Model.Fields = append(data.Model.Fields, f)
+	}
+	sortFields(data.Model.Fields)
+
+	data.CreateOutputField = outputField(pkg.structs[data.CreateOp+"Output"], output)
+
+	data.FindResultType = fmt.Sprintf("*awstypes.%s", output)
+	if data.ReadOp != "" && data.ReadOutputField == "" {
+		data.FindResultType = fmt.Sprintf("*%s.%sOutput", sdkService, data.ReadOp)
+	}
+
+	for _, v := range []string{"Update", "Modify"} {
+		if op := v + resName; pkg.operations[op] {
+			data.UpdateOp = op
+			break
+		}
+	}
+
+	if op := "Delete" + resName; pkg.operations[op] {
+		data.DeleteOp = op
+	}
+
+	for _, v := range []string{"List" + resName + "s", "List" + resName + "es", "Describe" + resName + "s"} {
+		if pkg.operations[v] {
+			data.ListOp = v
+			data.ListPaginated = pkg.functions["New"+v+"Paginator"]
+			for _, field := range structFields(pkg.structs[v+"Output"]) {
+				if strings.HasPrefix(field.typ, "[]types.") {
+					data.ListOutputField = field.name
+					item := pkg.typeStruct[strings.TrimPrefix(field.typ, "[]types.")]
+					for _, itemField := range structFields(item) {
+						if itemField.name == data.IDField || itemField.name == strings.TrimPrefix(data.IDField, resName) {
+							data.ListItemIDField = itemField.name
+							break
+						}
+					}
+					break
+				}
+			}
+			break
+		}
+	}
+
+	for _, v := range []string{"ResourceNotFoundException", "NotFoundException"} {
+		if _, ok := pkg.typeStruct[v]; ok {
+			data.NotFoundException = v
+			break
+		}
+	}
+
+	for _, f := range data.Model.Fields {
+		if f.Computed || f.Schema == "Tags" {
+			continue
+		}
+
+		// Without an update operation every argument forces replacement.
+		f.Replace = data.UpdateOp == "" || f.GoName == goFieldName(data.IDField)
+	}
+
+	sort.Strings(data.Unsupported)
+
+	return data, nil
+}
+
+type sdkField struct {
+	name     string
+	typ      string
+	required bool
+}
+
+func structFields(s *ast.StructType) []sdkField {
+	var fields []sdkField
+
+	if s == nil {
+		return fields
+	}
+
+	for _, field := range s.Fields.List {
+		for _, name := range field.Names {
+			if !name.IsExported() {
+				continue
+			}
+
+			fields = append(fields, sdkField{
+				name:     name.Name,
+				typ:      typeString(field.Type),
+				required: field.Doc != nil && strings.Contains(field.Doc.Text(), sdkRequiredMemberComment),
+			})
+		}
+	}
+
+	return fields
+}
+
+func typeString(expr ast.Expr) string {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v.Name
+	case *ast.StarExpr:
+		return "*" + typeString(v.X)
+	case *ast.ArrayType:
+		return "[]" + typeString(v.Elt)
+	case *ast.MapType:
+		return "map[" + typeString(v.Key) + "]" + typeString(v.Value)
+	case *ast.SelectorExpr:
+		return typeString(v.X) + "." + v.Sel.Name
+	case *ast.InterfaceType:
+		return "interface{}"
+	}
+
+	return fmt.Sprintf("%T", expr)
+}
+
+func skipField(name string) bool {
+	switch name {
+	case "MaxResults", "NextToken", "ResultMetadata", "Tags":
+		return true
+	}
+
+	return false
+}
+
+type modelBuilder struct {
+	pkg     *sdkPackage
+	data    *AutoFlexData
+	visited map[string]bool
+}
+
+// field returns the model field for the specified SDK field, or nil if autoflex cannot convert the field's type.
+func (b *modelBuilder) field(field sdkField, path string, argument bool) *AutoFlexField {
+	f := &AutoFlexField{
+		GoName:   goFieldName(field.name),
+		TFName:   ToSnakeCase(field.name, ""),
+		Required: argument && field.required,
+		Optional: argument && !field.required,
+		Computed: !argument,
+	}
+
+	typ := strings.TrimPrefix(field.typ, "*")
+	// Types in the service package refer to its types package; types in the types package refer to each other.
+	local := strings.TrimPrefix(typ, "types.")
+	local = strings.TrimPrefix(local, "[]types.")
+	if strings.HasPrefix(typ, "[]") && !strings.HasPrefix(typ, "[]types.") {
+		local = strings.TrimPrefix(typ, "[]")
+	}
+
+	switch {
+	case typ == "string":
+		f.GoType, f.Schema = "types.String", "StringAttribute"
+	case typ == "bool":
+		f.GoType, f.Schema = "types.Bool", "BoolAttribute"
+	case typ == "int32" || typ == "int64":
+		f.GoType, f.Schema = "types.Int64", "Int64Attribute"
+	case typ == "float32" || typ == "float64":
+		f.GoType, f.Schema = "types.Float64", "Float64Attribute"
+	case typ == "[]string":
+		f.GoType, f.Schema = "types.List", "ListAttribute"
+	case typ == "map[string]string":
+		f.GoType, f.Schema = "types.Map", "MapAttribute"
+	case typ == "time.Time":
+		f.GoType, f.Schema, f.Timestamp = "fwtypes.Timestamp", "StringAttribute", true
+	case b.pkg.typeKinds[local] == "string" && !strings.HasPrefix(typ, "[]"):
+		f.GoType, f.Schema, f.Enum = fmt.Sprintf("fwtypes.StringEnum[awstypes.%s]", local), "StringAttribute", local
+	case b.pkg.typeKinds[local] == "struct" && !b.visited[local]:
+		f.Schema = "ListAttribute"
+		if argument {
+			f.Schema = "ListNestedBlock"
+		}
+		f.MaxOne = !strings.HasPrefix(typ, "[]")
+		f.Nested = b.nestedModel(local, path+field.name+".", argument)
+		f.GoType = fmt.Sprintf("fwtypes.ListNestedObjectValueOf[%s]", f.Nested.Name)
+	default:
+		b.data.Unsupported = append(b.data.Unsupported, fmt.Sprintf("%s%s (%s)", path, field.name, field.typ))
+		return nil
+	}
+
+	return f
+}
+
+func (b *modelBuilder) nestedModel(typeName, path string, argument bool) *AutoFlexModel {
+	name := strings.ToLower(typeName[:1]) + typeName[1:] + "Model"
+
+	for _, m := range b.data.NestedModels {
+		if m.Name == name {
+			return m
+		}
+	}
+
+	b.visited[typeName] = true
+	defer delete(b.visited, typeName)
+
+	model := &AutoFlexModel{
+		Name: name,
+	}
+	for _, field := range structFields(b.pkg.typeStruct[typeName]) {
+		if f := b.field(field, path, argument); f != nil {
+			model.Fields = append(model.Fields, f)
+		}
+	}
+	sortFields(model.Fields)
+
+	b.data.NestedModels = append(b.data.NestedModels, model)
+
+	return model
+}
+
+func sortFields(fields []*AutoFlexField) {
+	sort.Slice(fields, func(i, j int) bool {
+		return fields[i].TFName < fields[j].TFName
+	})
+}
+
+// goFieldName returns the model field name for an SDK field name, using the provider's initialisms.
+// AutoFlex matches field names case-insensitively.
+func goFieldName(name string) string {
+	for _, v := range []struct{ from, to string }{{"Arn", "ARN"}, {"Id", "ID"}} {
+		if strings.HasSuffix(name, v.from) {
+			return strings.TrimSuffix(name, v.from) + v.to
+		}
+	}
+
+	return name
+}
+
+// identifierField returns the SDK field name used as the resource's ID.
+func identifierField(resName string, fields map[string]*AutoFlexField) string {
+	for _, v := range []string{resName + "Id", "Id", resName + "Arn", "Arn", resName + "Name", "Name"} {
+		if f, ok := fields[goFieldName(v)]; ok && f.Nested == nil {
+			return v
+		}
+	}
+
+	return ""
+}
+
+// outputField returns the name of the field in an operation's output struct that holds the specified type.
+func outputField(s *ast.StructType, typeName string) string {
+	for _, field := range structFields(s) {
+		if field.typ == "*types."+typeName {
+			return field.name
+		}
+	}
+
+	return ""
+}
+
+var autoFlexFuncs = template.FuncMap{
+	"hasBlocks": func(m *AutoFlexModel) bool {
+		for _, f := range m.Fields {
+			if f.Block() {
+				return true
+			}
+		}
+
+		return false
+	},
+	"hasSuffix": strings.HasSuffix,
+}
+
+func executeTemplate(templateName, tmpl, name string, td any) ([]byte, error) {
+	tplate, err := template.New(templateName).Funcs(autoFlexFuncs).Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing template: %s", err)
+	}
+
+	var buffer bytes.Buffer
+	if name == "" {
+		err = tplate.Execute(&buffer, td)
+	} else {
+		err = tplate.ExecuteTemplate(&buffer, name, td)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error executing template: %s", err)
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// writeGoTemplate writes a Go source file from a template, removing unused imports and formatting the result.
+func writeGoTemplate(templateName, filename, tmpl string, force bool, td any) error {
+	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
+		return fmt.Errorf("file (%s) already exists and force is not set", filename)
+	}
+
+	src, err := executeTemplate(templateName, tmpl, "", td)
+	if err != nil {
+		return err
+	}
+
+	return writeGoSource(filename, src)
+}
+
+func writeGoSource(filename string, src []byte) error {
+	src, err := pruneImports(src)
+	if err != nil {
+		return fmt.Errorf("error formatting generated file (%s): %s", filename, err)
+	}
+
+	if err := os.WriteFile(filename, src, 0644); err != nil {
+		return fmt.Errorf("error writing to file (%s): %s", filename, err)
+	}
+
+	return nil
+}
+
+// pruneImports removes the imports that a Go source file does not use and formats the result.
+// Blank and dot imports, and imports whose package name cannot be derived from the import path, are kept.
+func pruneImports(src []byte) ([]byte, error) {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "", src, parser.ParseComments)
+	if err != nil {
+		return nil, err
+	}
+
+	used := make(map[string]bool)
+	ast.Inspect(file, func(n ast.Node) bool {
+		if v, ok := n.(*ast.SelectorExpr); ok {
+			if x, ok := v.X.(*ast.Ident); ok {
+				used[x.Name] = true
+			}
+		}
+		return true
+	})
+
+	// Unused imports are removed line by line so that no blank lines are left in the import block.
+	var lines [][2]int
+	for _, spec := range file.Imports {
+		if name := importName(spec); name == "" || used[name] {
+			continue
+		}
+
+		start, end := fset.Position(spec.Pos()).Offset, fset.Position(spec.End()).Offset
+		for start > 0 && src[start-1] != '\n' {
+			start--
+		}
+		if end < len(src) && src[end] == '\n' {
+			end++
+		}
+		lines = append(lines, [2]int{start, end})
+	}
+
+	for i := len(lines) - 1; i >= 0; i-- {
+		src = append(src[:lines[i][0]:lines[i][0]], src[lines[i][1]:]...)
+	}
+
+	return format.Source(src)
+}
+
+// importName returns the name by which an import is referred to, or "" if it cannot be determined.
+func importName(spec *ast.ImportSpec) string {
+	if spec.Name != nil {
+		if spec.Name.Name == "_" || spec.Name.Name == "." {
+			return ""
+		}
+		return spec.Name.Name
+	}
+
+	path, err := strconv.Unquote(spec.Path.Value)
+	if err != nil {
+		return ""
+	}
+
+	elems := strings.Split(path, "/")
+	name := elems[len(elems)-1]
+	if len(elems) > 1 && regexache.MustCompile(`^v[0-9]+$`).MatchString(name) {
+		name = elems[len(elems)-2]
+	}
+	if strings.ContainsAny(name, "-.") {
+		return ""
+	}
+
+	return name
+}
+
+// updateSweepFile registers the resource's sweeper in the service package's sweep.go, creating the file if necessary.
+func updateSweepFile(filename string, td any) error {
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		src, err = executeTemplate("sweep", sweepAutoFlexTmpl, "file", td)
+		if err != nil {
+			return err
+		}
+
+		return writeGoSource(filename, src)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	imports, err := executeTemplate("sweep", sweepAutoFlexTmpl, "imports", td)
+	if err != nil {
+		return err
+	}
+
+	s, err := addImports(string(src), strings.Fields(string(imports)))
+	if err != nil {
+		return fmt.Errorf("file (%s): %w", filename, err)
+	}
+
+	const marker = "func RegisterSweepers() {"
+	i := strings.Index(s, marker)
+	if i == -1 {
+		return fmt.Errorf("%q not found in file (%s)", marker, filename)
+	}
+	i += len(marker)
+
+	register, err := executeTemplate("sweep", sweepAutoFlexTmpl, "register", td)
+	if err != nil {
+		return err
+	}
+
+	s = s[:i] + string(register) + s[i:]
+
+	f, err := executeTemplate("sweep", sweepAutoFlexTmpl, "func", td)
+	if err != nil {
+		return err
+	}
+
+	return writeGoSource(filename, []byte(s+string(f)+"\n"))
+}
+
+// addImports adds the quoted import paths that are missing from a Go source file's import block.
+// Standard library imports are added to the block's first group and all others to its last group.
+func addImports(src string, imports []string) (string, error) {
+	start := strings.Index(src, "import (\n")
+	if start == -1 {
+		return "", errors.New("import block not found")
+	}
+	start += len("import (\n")
+
+	end := start + strings.Index(src[start:], ")")
+
+	var std, other string
+	for _, v := range imports {
+		if strings.Contains(src[start:end], v) {
+			continue
+		}
+
+		if strings.Contains(strings.SplitN(v, "/", 2)[0], ".") {
+			other += "\t" + v + "\n"
+		} else {
+			std += "\t" + v + "\n"
+		}
+	}
+
+	return src[:start] + std + src[start:end] + other + src[end:], nil
+}
+
+// updateExportsFile exports the resource and its finder in the service package's exports_test.go, creating the file if necessary.
+func updateExportsFile(filename string, td any) error {
+	src, err := os.ReadFile(filename)
+	if errors.Is(err, fs.ErrNotExist) {
+		src, err = executeTemplate("exports", exportsAutoFlexTmpl, "", td)
+		if err != nil {
+			return err
+		}
+
+		return writeGoSource(filename, src)
+	}
+	if err != nil {
+		return fmt.Errorf("error reading file (%s): %s", filename, err)
+	}
+
+	const marker = "var ("
+	s := string(src)
+	i := strings.Index(s, marker)
+	if i == -1 {
+		return fmt.Errorf("%q not found in file (%s)", marker, filename)
+	}
+	i += len(marker)
+
+	insert, err := executeTemplate("exports", exportsAutoFlexTmpl, "vars", td)
+	if err != nil {
+		return err
+	}
+
+	// Skip exports that are already declared.
+	var lines []string
+	for _, line := range strings.Split(string(insert), "\n") {
+		if v := strings.Fields(line); len(v) == 0 || !strings.Contains(s, "\t"+v[0]+" ") {
+			lines = append(lines, line)
+		}
+	}
+
+	return writeGoSource(filename, []byte(s[:i]+strings.Join(lines, "\n")+"\n"+s[i:]))
+}
+
+const exportsAutoFlexTmpl = `
+{{- define "vars" }}
+	Resource{{ .Resource }} = newResource{{ .Resource }}
+	Find{{ .Resource }}ByID = find{{ .Resource }}ByID
+{{- end -}}
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package {{ .ServicePackage }}
+
+// Exports for use in tests only.
+var (
+	{{- template "vars" . }}
+)
+`
@@ -0,0 +1,120 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"testing"
+)
+
+func TestGoFieldName(t *testing.T) {
+	testCases := []struct {
+		TestName string
+		Input    string
+		Expected string
+	}{
+		{
+			TestName: "simple",
+			Input:    "Name",
+			Expected: "Name",
+		},
+		{
+			TestName: "arn",
+			Input:    "BrokerArn",
+			Expected: "BrokerARN",
+		},
+		{
+			TestName: "id",
+			Input:    "Id",
+			Expected: "ID",
+		},
+		{
+			TestName: "not suffix",
+			Input:    "Identity",
+			Expected: "Identity",
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.TestName, func(t *testing.T) {
+			got := goFieldName(testCase.Input)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
+
+func TestPruneImports(t *testing.T) {
+	input := `package test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/go-multierror"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/cenkalti/backoff/v4"
+	_ "embed"
+)
+
+func f(ctx context.Context) *string {
+	return aws.String("")
+}
+`
+	expected := `package test
+
+import (
+	"context"
+
+	_ "embed"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/go-multierror"
+)
+
+func f(ctx context.Context) *string {
+	return aws.String("")
+}
+`
+
+	got, err := pruneImports([]byte(input))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if string(got) != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+}
+
+func TestAddImports(t *testing.T) {
+	input := `package test
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+`
+	expected := `package test
+
+import (
+	"fmt"
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+)
+`
+
+	got, err := addImports(input, []string{`"context"`, `"fmt"`, `"github.com/hashicorp/terraform-provider-aws/internal/conns"`, `"github.com/hashicorp/terraform-provider-aws/internal/sweep"`})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got != expected {
+		t.Errorf("got:\n%s\nexpected:\n%s", got, expected)
+	}
+}
@@ -30,6 +30,15 @@ var resourceTestTmpl string
 //go:embed websitedoc.tmpl
 var websiteTmpl string
 
+//go:embed resourceautoflex.tmpl
+var resourceAutoFlexTmpl string
+
+//go:embed resourceautoflextest.tmpl
+var resourceAutoFlexTestTmpl string
+
+//go:embed sweepautoflex.tmpl
+var sweepAutoFlexTmpl string
+
 type TemplateData struct {
 	Resource             string
 	ResourceLower        string
@@ -72,43 +81,133 @@ func ProviderResourceName(servicePackage, snakeName string) string {
 }
 
 func Create(resName, snakeName string, comments, force, v2, pluginFramework, tags bool) error {
-	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	templateData, err := newTemplateData(resName, snakeName, comments, v2, pluginFramework, tags)
+	if err != nil {
+		return err
+	}
+
+	tmpl := resourceTmpl
+	if pluginFramework {
+		tmpl = resourceFrameworkTmpl
+	}
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+// CreateAutoFlex creates a Plugin Framework resource whose model, schema and CRUD methods are derived from
+// the specified AWS SDK for Go v2 input and output structs, together with a skeleton acceptance test and sweeper.
+func CreateAutoFlex(resName, snakeName, sdkService, input, output string, comments, force, tags bool) error {
+	templateData, err := newTemplateData(resName, snakeName, comments, true, true, tags)
+	if err != nil {
+		return err
+	}
+
+	if sdkService == "" {
+		sdkService, err = names.AWSGoV2Package(templateData.ServicePackage)
+		if err != nil {
+			return fmt.Errorf("error getting AWS SDK for Go v2 package name: %w", err)
+		}
+	}
+
+	if output == "" {
+		output = resName
+	}
+
+	wd, err := os.Getwd()
 	if err != nil {
 		return fmt.Errorf("error reading working directory: %s", err)
 	}
 
+	autoFlexData, err := NewAutoFlexData(sdkService, input, output, resName, wd, tags)
+	if err != nil {
+		return err
+	}
+
+	td := struct {
+		TemplateData
+		*AutoFlexData
+	}{templateData, autoFlexData}
+
+	f := fmt.Sprintf("%s.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("newres", f, resourceAutoFlexTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource template: %w", err)
+	}
+
+	tf := fmt.Sprintf("%s_test.go", templateData.ResourceSnake)
+	if err = writeGoTemplate("restest", tf, resourceAutoFlexTestTmpl, force, td); err != nil {
+		return fmt.Errorf("writing resource test template: %w", err)
+	}
+
+	if err = updateSweepFile("sweep.go", td); err != nil {
+		return fmt.Errorf("writing sweeper: %w", err)
+	}
+
+	if err = updateExportsFile("exports_test.go", td); err != nil {
+		return fmt.Errorf("writing test exports: %w", err)
+	}
+
+	wf := fmt.Sprintf("%s_%s.html.markdown", templateData.ServicePackage, templateData.ResourceSnake)
+	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
+	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
+		return fmt.Errorf("writing resource website doc template: %w", err)
+	}
+
+	return nil
+}
+
+func newTemplateData(resName, snakeName string, comments, v2, pluginFramework, tags bool) (TemplateData, error) {
+	wd, err := os.Getwd() // os.Getenv("GOPACKAGE") not available since this is not run with go generate
+	if err != nil {
+		return TemplateData{}, fmt.Errorf("error reading working directory: %s", err)
+	}
+
 	servicePackage := filepath.Base(wd)
 
 	if resName == "" {
-		return fmt.Errorf("error checking: no name given")
+		return TemplateData{}, fmt.Errorf("error checking: no name given")
 	}
 
 	if resName == strings.ToLower(resName) {
-		return fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
+		return TemplateData{}, fmt.Errorf("error checking: name should be properly capitalized (e.g., DBInstance)")
 	}
 
 	if snakeName != "" && snakeName != strings.ToLower(snakeName) {
-		return fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
+		return TemplateData{}, fmt.Errorf("error checking: snake name should be all lower case with underscores, if needed (e.g., db_instance)")
 	}
 
 	snakeName = ToSnakeCase(resName, snakeName)
 
 	s, err := names.ProviderNameUpper(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting service connection name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting service connection name: %w", err)
 	}
 
 	sn, err := names.FullHumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting AWS service name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting AWS service name: %w", err)
 	}
 
 	hf, err := names.HumanFriendly(servicePackage)
 	if err != nil {
-		return fmt.Errorf("error getting human-friendly name: %w", err)
+		return TemplateData{}, fmt.Errorf("error getting human-friendly name: %w", err)
 	}
 
-	templateData := TemplateData{
+	return TemplateData{
 		Resource:             resName,
 		ResourceLower:        strings.ToLower(resName),
 		ResourceSnake:        snakeName,
@@ -123,32 +222,10 @@ func Create(resName, snakeName string, comments, force, v2, pluginFramework, tag
 		PluginFramework:      pluginFramework,
 		HumanResourceName:    HumanResName(resName),
 		ProviderResourceName: ProviderResourceName(servicePackage, snakeName),
-	}
-
-	tmpl := resourceTmpl
-	if pluginFramework {
-		tmpl = resourceFrameworkTmpl
-	}
-	f := fmt.Sprintf("%s.go", snakeName)
-	if err = writeTemplate("newres", f, tmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource template: %w", err)
-	}
-
-	tf := fmt.Sprintf("%s_test.go", snakeName)
-	if err = writeTemplate("restest", tf, resourceTestTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource test template: %w", err)
-	}
-
-	wf := fmt.Sprintf("%s_%s.html.markdown", servicePackage, snakeName)
-	wf = filepath.Join("..", "..", "..", "website", "docs", "r", wf)
-	if err = writeTemplate("webdoc", wf, websiteTmpl, force, templateData); err != nil {
-		return fmt.Errorf("writing resource website doc template: %w", err)
-	}
-
-	return nil
+	}, nil
 }
 
-func writeTemplate(templateName, filename, tmpl string, force bool, td TemplateData) error {
+func writeTemplate(templateName, filename, tmpl string, force bool, td any) error {
 	if _, err := os.Stat(filename); !errors.Is(err, fs.ErrNotExist) && !force {
 		return fmt.Errorf("file (%s) already exists and force is not set", filename)
 	}
@@ -11,9 +11,13 @@ import (
 	"io"
 	"os"
 	"path"
+	"reflect"
+	"runtime"
 	"sort"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
 	"github.com/hashicorp/terraform-provider-aws/internal/provider"
@@ -90,6 +94,10 @@ func main() {
 	if err := migrator.migrate(outputFilename); err != nil {
 		g.Fatalf("error migrating Terraform %s schema: %s", *resourceType, err)
 	}
+
+	for _, v := range migrator.Unsupported {
+		g.Warnf("Not migrated: %s", v)
+	}
 }
 
 type migrator struct {
@@ -100,9 +108,10 @@ type migrator struct {
 	Resource     *schema.Resource
 	Template     string
 	TFTypeName   string
+	Unsupported  []string // Plugin SDK constructs that could not be migrated.
 }
 
-// migrate generates an identical schema into the specified output file.
+// migrate generates an identical schema, model structs and CRUD scaffolding into the specified output file.
 func (m *migrator) migrate(outputFilename string) error {
 	m.infof("generating into %[1]q", outputFilename)
 
@@ -132,9 +141,11 @@ func (m *migrator) migrate(outputFilename string) error {
 func (m *migrator) generateTemplateData() (*templateData, error) {
 	sbSchema := strings.Builder{}
 	sbStruct := strings.Builder{}
+	sbModels := strings.Builder{}
 	emitter := &emitter{
 		Generator:    m.Generator,
 		IsDataSource: m.IsDataSource,
+		ModelWriter:  &sbModels,
 		SchemaWriter: &sbSchema,
 		StructWriter: &sbStruct,
 	}
@@ -145,24 +156,60 @@ func (m *migrator) generateTemplateData() (*templateData, error) {
 		return nil, fmt.Errorf("emitting schema code: %w", err)
 	}
 
+	resource := m.Resource
 	templateData := &templateData{
-		DefaultCreateTimeout:         emitter.DefaultCreateTimeout,
-		DefaultReadTimeout:           emitter.DefaultReadTimeout,
-		DefaultUpdateTimeout:         emitter.DefaultUpdateTimeout,
-		DefaultDeleteTimeout:         emitter.DefaultDeleteTimeout,
-		EmitResourceImportState:      m.Resource.Importer != nil,
-		EmitResourceModifyPlan:       !m.IsDataSource && emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap,
-		EmitResourceUpdateSkeleton:   m.Resource.Update != nil || m.Resource.UpdateContext != nil || m.Resource.UpdateWithoutTimeout != nil,
-		HasTimeouts:                  emitter.HasTimeouts,
-		ImportFrameworkAttr:          emitter.ImportFrameworkAttr,
-		ImportProviderFrameworkTypes: emitter.ImportProviderFrameworkTypes,
-		Name:                         m.Name,
-		PackageName:                  m.PackageName,
-		Schema:                       sbSchema.String(),
-		Struct:                       sbStruct.String(),
-		TFTypeName:                   m.TFTypeName,
+		CreateFunc:                 funcName(resource.CreateWithoutTimeout, resource.CreateContext, resource.Create),
+		DefaultCreateTimeout:       durationString(emitter.DefaultCreateTimeout),
+		DefaultReadTimeout:         durationString(emitter.DefaultReadTimeout),
+		DefaultUpdateTimeout:       durationString(emitter.DefaultUpdateTimeout),
+		DefaultDeleteTimeout:       durationString(emitter.DefaultDeleteTimeout),
+		DeleteFunc:                 funcName(resource.DeleteWithoutTimeout, resource.DeleteContext, resource.Delete),
+		EmitResourceImportState:    resource.Importer != nil,
+		EmitResourceUpdateSkeleton: resource.Update != nil || resource.UpdateContext != nil || resource.UpdateWithoutTimeout != nil,
+		HasTimeouts:                emitter.HasTimeouts,
+		Name:                       m.Name,
+		PackageName:                m.PackageName,
+		ReadFunc:                   funcName(resource.ReadWithoutTimeout, resource.ReadContext, resource.Read),
+		Schema:                     sbSchema.String(),
+		Struct:                     sbStruct.String(),
+		TFTypeName:                 m.TFTypeName,
+		UpdateFunc:                 funcName(resource.UpdateWithoutTimeout, resource.UpdateContext, resource.Update),
 	}
 
+	if !m.IsDataSource {
+		templateData.CustomizeDiffFunc = funcName(resource.CustomizeDiff)
+		templateData.EmitResourceSetTagsAll = emitter.HasTopLevelTagsAllMap && emitter.HasTopLevelTagsMap
+		templateData.EmitResourceModifyPlan = templateData.EmitResourceSetTagsAll || templateData.CustomizeDiffFunc != ""
+
+		if v := templateData.CustomizeDiffFunc; v != "" {
+			emitter.unsupportedf(nil, "CustomizeDiff %s, see ModifyPlan", v)
+		}
+
+		if v := resource.Importer; v != nil && v.StateContext != nil && funcName(v.StateContext) != funcName(schema.ImportStatePassthroughContext) {
+			emitter.unsupportedf(nil, "Importer %s, see ImportState", funcName(v.StateContext))
+		}
+
+		if v := resource.MigrateState; v != nil {
+			emitter.unsupportedf(nil, "MigrateState %s, flatmap state must be upgraded by an earlier provider version", funcName(v))
+		}
+
+		for _, v := range resource.StateUpgraders {
+			upgrader, err := emitter.emitStateUpgrader(v)
+
+			if err != nil {
+				return nil, fmt.Errorf("emitting state upgrader code: %w", err)
+			}
+
+			templateData.StateUpgraders = append(templateData.StateUpgraders, upgrader)
+		}
+	}
+
+	templateData.ImportFrameworkAttr = emitter.ImportFrameworkAttr
+	templateData.ImportProviderFrameworkTypes = emitter.ImportProviderFrameworkTypes
+	templateData.Models = sbModels.String()
+	templateData.Unsupported = emitter.Unsupported
+	m.Unsupported = emitter.Unsupported
+
 	for _, v := range emitter.FrameworkPlanModifierPackages {
 		if !slices.Contains(templateData.FrameworkPlanModifierPackages, v) {
 			templateData.FrameworkPlanModifierPackages = append(templateData.FrameworkPlanModifierPackages, v)
@@ -187,10 +234,10 @@ func (m *migrator) infof(format string, a ...interface{}) {
 }
 
 type emitter struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	DefaultCreateTimeout          time.Duration
+	DefaultReadTimeout            time.Duration
+	DefaultUpdateTimeout          time.Duration
+	DefaultDeleteTimeout          time.Duration
 	Generator                     *common.Generator
 	FrameworkPlanModifierPackages []string // Package names for any terraform-plugin-framework plan modifiers. May contain duplicates.
 	FrameworkValidatorsPackages   []string // Package names for any terraform-plugin-framework-validators validators. May contain duplicates.
@@ -200,9 +247,12 @@ type emitter struct {
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
 	IsDataSource                  bool
+	ModelNames                    []string // Names of the nested block model structs.
+	ModelWriter                   io.Writer
 	ProviderPlanModifierPackages  []string // Package names for any provider plan modifiers. May contain duplicates.
 	SchemaWriter                  io.Writer
 	StructWriter                  io.Writer
+	Unsupported                   []string // Plugin SDK constructs that could not be migrated.
 }
 
 // emitSchemaForResource generates the Plugin Framework code for a Plugin SDK Resource and emits the generated code to the emitter's Writer.
@@ -221,16 +271,16 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 		e.HasTimeouts = true
 
 		if v := v.Create; v != nil {
-			e.DefaultCreateTimeout = int64(*v)
+			e.DefaultCreateTimeout = *v
 		}
 		if v := v.Read; v != nil {
-			e.DefaultReadTimeout = int64(*v)
+			e.DefaultReadTimeout = *v
 		}
 		if v := v.Update; v != nil {
-			e.DefaultUpdateTimeout = int64(*v)
+			e.DefaultUpdateTimeout = *v
 		}
 		if v := v.Delete; v != nil {
-			e.DefaultDeleteTimeout = int64(*v)
+			e.DefaultDeleteTimeout = *v
 		}
 	}
 
@@ -263,8 +313,6 @@ func (e *emitter) emitSchemaForResource(resource *schema.Resource) error {
 // and emits the generated code to the emitter's Writer.
 // Property names are sorted prior to code generation to reduce diffs.
 func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*schema.Schema) error {
-	isTopLevelAttribute := len(path) == 0
-
 	// At this point we are emitting code for a schema.Block or Schema.
 	names := make([]string, 0)
 	for name := range schema {
@@ -286,10 +334,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
-
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
-		}
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitAttributeProperty(append(path, name), property)
 
@@ -297,9 +342,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
-		}
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 
 		fprintf(e.SchemaWriter, ",\n")
 	}
@@ -321,6 +364,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 		}
 
 		fprintf(e.SchemaWriter, "%q:", name)
+		fprintf(e.StructWriter, "%s ", naming.ToCamelCase(name))
 
 		err := e.emitBlockProperty(append(path, name), property)
 
@@ -328,6 +372,7 @@ func (e *emitter) emitAttributesAndBlocks(path []string, schema map[string]*sche
 			return err
 		}
 
+		fprintf(e.StructWriter, " `tfsdk:%q`\n", name)
 		fprintf(e.SchemaWriter, ",\n")
 	}
 	if emittedFieldName {
@@ -354,9 +399,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeBool:
 		fprintf(e.SchemaWriter, "schema.BoolAttribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Bool")
-		}
+		fprintf(e.StructWriter, "types.Bool")
 
 		fwPlanModifierPackage = "boolplanmodifier"
 		fwPlanModifierType = "Bool"
@@ -364,9 +407,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeFloat:
 		fprintf(e.SchemaWriter, "schema.Float64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Float64")
-		}
+		fprintf(e.StructWriter, "types.Float64")
 
 		fwPlanModifierPackage = "float64planmodifier"
 		fwPlanModifierType = "Float64"
@@ -374,9 +415,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 	case schema.TypeInt:
 		fprintf(e.SchemaWriter, "schema.Int64Attribute{\n")
 
-		if isTopLevelAttribute {
-			fprintf(e.StructWriter, "types.Int64")
-		}
+		fprintf(e.StructWriter, "types.Int64")
 
 		fwPlanModifierPackage = "int64planmodifier"
 		fwPlanModifierType = "Int64"
@@ -389,9 +428,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 			fprintf(e.SchemaWriter, "CustomType:fwtypes.ARNType,\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "fwtypes.ARN")
-			}
+			fprintf(e.StructWriter, "fwtypes.ARN")
 		} else {
 			if isTopLevelAttribute && attributeName == "id" {
 				fprintf(e.SchemaWriter, "// TODO framework.IDAttribute()\n")
@@ -399,9 +436,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 
 			fprintf(e.SchemaWriter, "schema.StringAttribute{\n")
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.String")
-			}
+			fprintf(e.StructWriter, "types.String")
 		}
 
 		fwPlanModifierPackage = "stringplanmodifier"
@@ -418,9 +453,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.ListAttribute{"
 			typeName = "list"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.List")
-			}
+			fprintf(e.StructWriter, "types.List")
 
 			fwPlanModifierPackage = "listplanmodifier"
 			fwPlanModifierType = "List"
@@ -431,9 +464,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.MapAttribute{"
 			typeName = "map"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Map")
-			}
+			fprintf(e.StructWriter, "types.Map")
 
 			fwPlanModifierPackage = "mapplanmodifier"
 			fwPlanModifierType = "Map"
@@ -444,9 +475,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			aggregateSchemaFactory = "schema.SetAttribute{"
 			typeName = "set"
 
-			if isTopLevelAttribute {
-				fprintf(e.StructWriter, "types.Set")
-			}
+			fprintf(e.StructWriter, "types.Set")
 
 			fwPlanModifierPackage = "setplanmodifier"
 			fwPlanModifierType = "Set"
@@ -562,10 +591,13 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		switch v := def.(type) {
 		case bool:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.unsupportedf(path, "Default %#v", def)
 		case int:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.unsupportedf(path, "Default %#v", def)
 		case float64:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.unsupportedf(path, "Default %#v", def)
 		case string:
 			providerPlanModifierPackage = "stringplanmodifier"
 			// Alias the provider plan modifier package name with an "fw" prefix. See also resource.tmpl.
@@ -573,6 +605,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 			e.ProviderPlanModifierPackages = append(e.ProviderPlanModifierPackages, providerPlanModifierPackage)
 		default:
 			fprintf(e.SchemaWriter, "// TODO Default:%#v,\n", def)
+			e.unsupportedf(path, "Default %#v", def)
 		}
 	}
 
@@ -584,11 +617,7 @@ func (e *emitter) emitAttributeProperty(path []string, property *schema.Schema)
 		fprintf(e.SchemaWriter, "},\n")
 	}
 
-	// Features that we can't (yet) migrate:
-
-	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
-		fprintf(e.SchemaWriter, "// TODO Validate,\n")
-	}
+	e.emitUnmigratedFeatures(path, property)
 
 	fprintf(e.SchemaWriter, "}")
 
@@ -614,17 +643,10 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "listvalidator"
 			fwValidatorType = "List"
 
-			fprintf(e.SchemaWriter, "schema.ListNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
+			if err := e.emitNestedBlockObject(path, "List", v); err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
-
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) list of %T", v))
 		}
@@ -637,17 +659,10 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 			fwValidatorsPackage = "setvalidator"
 			fwValidatorType = "Set"
 
-			fprintf(e.SchemaWriter, "schema.SetNestedBlock{\n")
-			fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
-
-			err := e.emitAttributesAndBlocks(path, v.Schema)
-
-			if err != nil {
+			if err := e.emitNestedBlockObject(path, "Set", v); err != nil {
 				return err
 			}
 
-			fprintf(e.SchemaWriter, "},\n")
-
 		default:
 			return unsupportedTypeError(path, fmt.Sprintf("(Block) set of %T", v))
 		}
@@ -704,14 +719,229 @@ func (e *emitter) emitBlockProperty(path []string, property *schema.Schema) erro
 	}
 
 	if def := property.Default; def != nil {
-		e.warnf("Block %s has non-nil Default: %v", strings.Join(path, "/"), def)
+		e.unsupportedf(path, "Default %#v", def)
 	}
 
+	e.emitUnmigratedFeatures(path, property)
+
 	fprintf(e.SchemaWriter, "}")
 
 	return nil
 }
 
+// emitNestedBlockObject generates the Plugin Framework code for a Plugin SDK Block's nested object
+// and emits the generated code to the emitter's Writer.
+// The block's model struct is emitted to the emitter's ModelWriter.
+func (e *emitter) emitNestedBlockObject(path []string, kind string, resource *schema.Resource) error {
+	modelName := e.modelName(path)
+
+	e.ImportProviderFrameworkTypes = true
+
+	fprintf(e.StructWriter, "fwtypes.%sNestedObjectValueOf[%s]", kind, modelName)
+
+	fprintf(e.SchemaWriter, "schema.%sNestedBlock{\n", kind)
+	fprintf(e.SchemaWriter, "CustomType:fwtypes.New%sNestedObjectTypeOf[%s](ctx),\n", kind, modelName)
+	fprintf(e.SchemaWriter, "NestedObject:schema.NestedBlockObject{\n")
+
+	sbStruct := strings.Builder{}
+	structWriter := e.StructWriter
+	e.StructWriter = &sbStruct
+
+	err := e.emitAttributesAndBlocks(path, resource.Schema)
+
+	e.StructWriter = structWriter
+
+	if err != nil {
+		return err
+	}
+
+	fprintf(e.SchemaWriter, "},\n")
+
+	fprintf(e.ModelWriter, "type %s struct {\n%s}\n\n", modelName, sbStruct.String())
+
+	return nil
+}
+
+// modelName returns a unique name for the model struct of the nested block at the specified path.
+func (e *emitter) modelName(path []string) string {
+	name := naming.ToCamelCase(path[len(path)-1])
+
+	if slices.Contains(e.ModelNames, name) {
+		name = naming.ToCamelCase(strings.Join(path, "_"))
+	}
+
+	e.ModelNames = append(e.ModelNames, name)
+
+	return strings.ToLower(name[:1]) + name[1:] + "Model"
+}
+
+// emitUnmigratedFeatures emits TODO comments for a Plugin SDK property's features that have no direct Plugin Framework equivalent.
+func (e *emitter) emitUnmigratedFeatures(path []string, property *schema.Schema) {
+	var features []string
+
+	if property.ValidateFunc != nil || property.ValidateDiagFunc != nil {
+		features = append(features, "Validate")
+	}
+	if property.DiffSuppressFunc != nil {
+		features = append(features, "DiffSuppressFunc")
+	}
+	if property.StateFunc != nil {
+		features = append(features, "StateFunc")
+	}
+	if property.Set != nil {
+		features = append(features, "Set")
+	}
+	if len(property.ConflictsWith) > 0 {
+		features = append(features, "ConflictsWith")
+	}
+	if len(property.ExactlyOneOf) > 0 {
+		features = append(features, "ExactlyOneOf")
+	}
+	if len(property.AtLeastOneOf) > 0 {
+		features = append(features, "AtLeastOneOf")
+	}
+	if len(property.RequiredWith) > 0 {
+		features = append(features, "RequiredWith")
+	}
+
+	for _, v := range features {
+		fprintf(e.SchemaWriter, "// TODO %s,\n", v)
+		e.unsupportedf(path, v)
+	}
+}
+
+// emitStateUpgrader generates the Plugin Framework code for a Plugin SDK state upgrader.
+// The prior schema and model struct are derived from the state upgrader's type.
+func (e *emitter) emitStateUpgrader(upgrader schema.StateUpgrader) (stateUpgraderData, error) {
+	sbSchema := strings.Builder{}
+	sbStruct := strings.Builder{}
+	path := []string{fmt.Sprintf("StateUpgraders[%d]", upgrader.Version)}
+
+	if !upgrader.Type.IsObjectType() {
+		return stateUpgraderData{}, unsupportedTypeError(path, upgrader.Type.FriendlyName())
+	}
+
+	fprintf(&sbSchema, "schema.Schema{\n")
+	fprintf(&sbSchema, "Attributes: map[string]schema.Attribute{\n")
+
+	attributeTypes := upgrader.Type.AttributeTypes()
+	names := make([]string, 0)
+	for name := range attributeTypes {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		path := append(path, name)
+		attributeType := attributeTypes[name]
+
+		attribute, valueType, err := e.priorAttribute(path, attributeType)
+
+		if err != nil {
+			return stateUpgraderData{}, err
+		}
+
+		fprintf(&sbSchema, "%q:schema.%s{\n", name, attribute)
+		if !attributeType.IsPrimitiveType() {
+			fprintf(&sbSchema, "ElementType:")
+			if err := e.emitCtyType(&sbSchema, path, attributeType.ElementType()); err != nil {
+				return stateUpgraderData{}, err
+			}
+			fprintf(&sbSchema, ",\n")
+		}
+		fprintf(&sbSchema, "Optional:true,\n")
+		fprintf(&sbSchema, "},\n")
+
+		fprintf(&sbStruct, "%s %s `tfsdk:%q`\n", naming.ToCamelCase(name), valueType, name)
+	}
+
+	fprintf(&sbSchema, "},\n")
+	fprintf(&sbSchema, "}")
+
+	e.unsupportedf(path, "Upgrade %s, see upgradeStateFromV%d", funcName(upgrader.Upgrade), upgrader.Version)
+
+	return stateUpgraderData{
+		PriorSchema: sbSchema.String(),
+		Struct:      sbStruct.String(),
+		UpgradeFunc: funcName(upgrader.Upgrade),
+		Version:     upgrader.Version,
+	}, nil
+}
+
+// priorAttribute returns the Plugin Framework schema attribute and value type names for a prior state attribute's type.
+func (e *emitter) priorAttribute(path []string, ty cty.Type) (string, string, error) {
+	switch {
+	case ty == cty.Bool:
+		return "BoolAttribute", "types.Bool", nil
+	case ty == cty.Number:
+		return "NumberAttribute", "types.Number", nil
+	case ty == cty.String:
+		return "StringAttribute", "types.String", nil
+	case ty.IsListType():
+		return "ListAttribute", "types.List", nil
+	case ty.IsMapType():
+		return "MapAttribute", "types.Map", nil
+	case ty.IsSetType():
+		return "SetAttribute", "types.Set", nil
+	}
+
+	return "", "", unsupportedTypeError(path, ty.FriendlyName())
+}
+
+// emitCtyType generates the Plugin Framework attr.Type code for a cty Type and emits the generated code to the specified Writer.
+func (e *emitter) emitCtyType(w io.Writer, path []string, ty cty.Type) error {
+	switch {
+	case ty == cty.Bool:
+		fprintf(w, "types.BoolType")
+	case ty == cty.Number:
+		fprintf(w, "types.NumberType")
+	case ty == cty.String:
+		fprintf(w, "types.StringType")
+	case ty.IsListType(), ty.IsMapType(), ty.IsSetType():
+		switch {
+		case ty.IsListType():
+			fprintf(w, "types.ListType{ElemType:")
+		case ty.IsMapType():
+			fprintf(w, "types.MapType{ElemType:")
+		case ty.IsSetType():
+			fprintf(w, "types.SetType{ElemType:")
+		}
+
+		if err := e.emitCtyType(w, path, ty.ElementType()); err != nil {
+			return err
+		}
+
+		fprintf(w, "}")
+	case ty.IsObjectType():
+		e.ImportFrameworkAttr = true
+
+		attributeTypes := ty.AttributeTypes()
+		names := make([]string, 0)
+		for name := range attributeTypes {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		fprintf(w, "types.ObjectType{\n")
+		fprintf(w, "AttrTypes: map[string]attr.Type{\n")
+		for _, name := range names {
+			fprintf(w, "%q:", name)
+
+			if err := e.emitCtyType(w, append(path, name), attributeTypes[name]); err != nil {
+				return err
+			}
+
+			fprintf(w, ",\n")
+		}
+		fprintf(w, "},\n")
+		fprintf(w, "}")
+	default:
+		return unsupportedTypeError(path, ty.FriendlyName())
+	}
+
+	return nil
+}
+
 // emitComputedOnlyBlock generates the Plugin Framework code for a Plugin SDK Computed-only nested block
 // and emits the generated code to the emitter's Writer.
 // See https://github.com/hashicorp/terraform-plugin-sdk/blob/6ffc92796f0716c07502e4d36aaafa5fd85e94cf/internal/configs/configschema/implied_type.go#L12.
@@ -835,6 +1065,17 @@ func (e *emitter) emitComputedOnlyBlockProperty(path []string, property *schema.
 	return nil
 }
 
+// unsupportedf records a Plugin SDK construct that could not be migrated.
+func (e *emitter) unsupportedf(path []string, format string, a ...interface{}) {
+	v := fmt.Sprintf(format, a...)
+
+	if len(path) > 0 {
+		v = fmt.Sprintf("%s: %s", strings.Join(path, "/"), v)
+	}
+
+	e.Unsupported = append(e.Unsupported, v)
+}
+
 // warnf emits a formatted warning message to the UI.
 func (e *emitter) warnf(format string, a ...interface{}) {
 	e.Generator.Warnf(format, a...)
@@ -882,25 +1123,75 @@ func unsupportedTypeError(path []string, typ string) error {
 	return fmt.Errorf("%s is of unsupported type: %s", strings.Join(path, "/"), typ)
 }
 
+// funcName returns the package-qualified name of the first non-nil function, e.g. "ec2.resourceVPCCreate".
+func funcName(fs ...any) string {
+	for _, f := range fs {
+		v := reflect.ValueOf(f)
+
+		if !v.IsValid() || v.Kind() != reflect.Func || v.IsNil() {
+			continue
+		}
+
+		if f := runtime.FuncForPC(v.Pointer()); f != nil {
+			name := f.Name()
+			return name[strings.LastIndex(name, "/")+1:]
+		}
+	}
+
+	return ""
+}
+
+// durationString returns Go code for the specified duration, e.g. "20 * time.Minute".
+func durationString(d time.Duration) string {
+	switch {
+	case d <= 0:
+		return ""
+	case d%time.Hour == 0:
+		return fmt.Sprintf("%d * time.Hour", d/time.Hour)
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	}
+
+	return fmt.Sprintf("%d * time.Nanosecond", d)
+}
+
 type templateData struct {
-	DefaultCreateTimeout          int64
-	DefaultReadTimeout            int64
-	DefaultUpdateTimeout          int64
-	DefaultDeleteTimeout          int64
+	CreateFunc                    string // e.g. ec2.resourceVPCCreate
+	CustomizeDiffFunc             string
+	DefaultCreateTimeout          string // e.g. 20 * time.Minute
+	DefaultReadTimeout            string
+	DefaultUpdateTimeout          string
+	DefaultDeleteTimeout          string
+	DeleteFunc                    string
 	EmitResourceImportState       bool
 	EmitResourceModifyPlan        bool
+	EmitResourceSetTagsAll        bool
 	EmitResourceUpdateSkeleton    bool
 	FrameworkPlanModifierPackages []string
 	FrameworkValidatorsPackages   []string
 	HasTimeouts                   bool
 	ImportFrameworkAttr           bool
 	ImportProviderFrameworkTypes  bool
+	Models                        string
 	Name                          string // e.g. Instance
 	PackageName                   string // e.g. ec2
 	ProviderPlanModifierPackages  []string
+	ReadFunc                      string
 	Schema                        string
+	StateUpgraders                []stateUpgraderData
 	Struct                        string
 	TFTypeName                    string // e.g. aws_instance
+	Unsupported                   []string
+	UpdateFunc                    string
+}
+
+type stateUpgraderData struct {
+	PriorSchema string
+	Struct      string
+	UpgradeFunc string // e.g. ec2.instanceStateUpgradeV0
+	Version     int
 }
 
 //go:embed datasource.tmpl